@@ -15,13 +15,21 @@
 package schemast
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"go/ast"
+	"go/printer"
+	"go/token"
+	"reflect"
 	"sort"
+	"sync"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
 	"github.com/mitchellh/mapstructure"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
@@ -42,20 +50,114 @@ type Annotator func(schema.Annotation) (ast.Expr, bool, error)
 // Annotation is an Annotator that searches a map of well-known ent annotation (entproto, entsql, etc.) and
 // invokes that Annotator if found.
 func Annotation(annot schema.Annotation) (ast.Expr, bool, error) {
-	annotators := map[string]Annotator{
-		entproto.MessageAnnotation: protoMsg,
-		entproto.ServiceAnnotation: protoSvc,
-		entproto.FieldAnnotation:   protoField,
-		entproto.EnumAnnotation:    protoEnum,
-		"EntSQL":                   entSQL,
-	}
-	fn, ok := annotators[annot.Name()]
+	fn, ok := lookupAnnotator(annot.Name())
 	if !ok {
 		return nil, false, &UnsupportedAnnotationError{annot: annot}
 	}
 	return fn(annot)
 }
 
+// UseReflectedAnnotations configures whether annotations that have no Annotator are rendered as composite
+// literals of their type, holding the values of its exported fields (e.g. mypkg.Annotation{Key: "v"}). This
+// is a best-effort fallback that only suits annotations which are plain structs, therefore it is disabled
+// by default and unknown annotations are reported with an UnsupportedAnnotationError.
+func UseReflectedAnnotations(enabled bool) {
+	annotators.Lock()
+	defer annotators.Unlock()
+	annotators.reflect = enabled
+}
+
+// reflectedAnnotation is the Annotator used for annotations that have no Annotator when
+// UseReflectedAnnotations is enabled.
+func reflectedAnnotation(annot schema.Annotation) (ast.Expr, bool, error) {
+	t := reflect.TypeOf(annot)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.PkgPath() == "" {
+		return nil, false, &UnsupportedAnnotationError{annot: annot}
+	}
+	expr, err := literalExpr(reflect.ValueOf(annot))
+	if err != nil {
+		return nil, false, err
+	}
+	return expr, true, nil
+}
+
+// annotationImports returns the import paths of the packages that declare the types of the annotations
+// rendered using reflectedAnnotation.
+func annotationImports(annots []schema.Annotation) []string {
+	annotators.RLock()
+	defer annotators.RUnlock()
+	if !annotators.reflect {
+		return nil
+	}
+	var paths []string
+	for _, annot := range annots {
+		if _, ok := annotators.m[annot.Name()]; !ok {
+			paths = append(paths, typeImports(reflect.TypeOf(annot))...)
+		}
+	}
+	return paths
+}
+
+// annotators holds the Annotator for each of the well-known ent annotations, as well as the ones registered
+// with RegisterAnnotation, keyed by annotation name.
+var annotators = struct {
+	sync.RWMutex
+	m map[string]Annotator
+	// reflect is set by UseReflectedAnnotations.
+	reflect bool
+}{m: map[string]Annotator{
+	entproto.MessageAnnotation: protoMsg,
+	entproto.ServiceAnnotation: protoSvc,
+	entproto.FieldAnnotation:   protoField,
+	entproto.EnumAnnotation:    protoEnum,
+	entproto.SkipAnnotation:    protoSkip,
+	"EntSQL":                   entSQL,
+	"EntSQLIndexes":            entSQLIndex,
+	"Fields":                   fieldAnnotation,
+	"EntGQL":                   entGQL,
+}}
+
+// RegisterAnnotation teaches schemast to render the annotations named name using fn, which returns the
+// expression that builds the passed annotation, e.g. a call to an option function of the package that
+// declares it. If fn returns a nil expression, the annotation is omitted from the generated code.
+// Registering an annotation name that is already known replaces its Annotator.
+// Example:
+//
+//	schemast.RegisterAnnotation("Audit", func(a schema.Annotation) (ast.Expr, error) {
+//		return &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("audit"), Sel: ast.NewIdent("Enabled")}}, nil
+//	})
+func RegisterAnnotation(name string, fn func(schema.Annotation) (ast.Expr, error)) error {
+	if name == "" || fn == nil {
+		return errors.New("schemast: expected an annotation name and a func to register")
+	}
+	annotators.Lock()
+	defer annotators.Unlock()
+	annotators.m[name] = func(annot schema.Annotation) (ast.Expr, bool, error) {
+		expr, err := fn(annot)
+		if err != nil {
+			return nil, false, err
+		}
+		return expr, expr != nil, nil
+	}
+	return nil
+}
+
+// lookupAnnotator returns the Annotator of the annotations named name.
+func lookupAnnotator(name string) (Annotator, bool) {
+	annotators.RLock()
+	defer annotators.RUnlock()
+	fn, ok := annotators.m[name]
+	if !ok && annotators.reflect {
+		return reflectedAnnotation, true
+	}
+	return fn, ok
+}
+
+// AppendTypeAnnotation adds annot to the returned values of the Annotations method of type typeName, adding
+// the method if it does not exist.
 func (c *Context) AppendTypeAnnotation(typeName string, annot schema.Annotation) error {
 	newAnnot, shouldAdd, err := Annotation(annot)
 	if err != nil {
@@ -64,7 +166,84 @@ func (c *Context) AppendTypeAnnotation(typeName string, annot schema.Annotation)
 	if !shouldAdd {
 		return nil
 	}
-	return c.appendReturnItem(kindAnnot, typeName, newAnnot)
+	if _, err := c.appendReturnItem(kindAnnot, typeName, newAnnot); err != nil {
+		return err
+	}
+	c.appendImports(c.methodFile(typeName, kindAnnot.methodName), annotationImports([]schema.Annotation{annot}))
+	return nil
+}
+
+// RemoveTypeAnnotation removes annot from the returned values of the Annotations method of type typeName.
+// Annotations are matched by the expression that builds them, e.g. entsql.Annotation{Table: "users"}.
+func (c *Context) RemoveTypeAnnotation(typeName string, annot schema.Annotation) error {
+	expr, _, err := Annotation(annot)
+	if err != nil {
+		return err
+	}
+	if expr != nil {
+		stmt, err := c.returnStmt(typeName, kindAnnot.methodName)
+		if err != nil {
+			return err
+		}
+		target, err := exprString(expr)
+		if err != nil {
+			return err
+		}
+		if returned, ok := stmt.Results[0].(*ast.CompositeLit); ok {
+			for i, item := range returned.Elts {
+				s, err := exprString(item)
+				if err != nil {
+					return err
+				}
+				if s == target {
+					returned.Elts = append(returned.Elts[:i], returned.Elts[i+1:]...)
+					return nil
+				}
+			}
+		}
+	}
+	return fmt.Errorf("schemast: could not find annotation %q in type %q", annot.Name(), typeName)
+}
+
+// hasTypeAnnotation reports whether the Annotations method of type typeName returns annot. Annotations are
+// matched like in RemoveTypeAnnotation.
+func (c *Context) hasTypeAnnotation(typeName string, annot schema.Annotation) (bool, error) {
+	if _, ok := c.lookupMethod(typeName, kindAnnot.methodName); !ok {
+		return false, nil
+	}
+	expr, ok, err := Annotation(annot)
+	if err != nil || !ok {
+		return false, err
+	}
+	target, err := exprString(expr)
+	if err != nil {
+		return false, err
+	}
+	stmt, err := c.returnStmt(typeName, kindAnnot.methodName)
+	if err != nil {
+		return false, err
+	}
+	if returned, ok := stmt.Results[0].(*ast.CompositeLit); ok {
+		for _, item := range returned.Elts {
+			s, err := exprString(item)
+			if err != nil {
+				return false, err
+			}
+			if s == target {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// exprString returns the source of expr printed on a single line, regardless of the positions of its nodes.
+func exprString(expr ast.Expr) (string, error) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), expr); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
 }
 
 func protoMsg(annot schema.Annotation) (ast.Expr, bool, error) {
@@ -88,6 +267,7 @@ func protoMsg(annot schema.Annotation) (ast.Expr, bool, error) {
 func protoSvc(annot schema.Annotation) (ast.Expr, bool, error) {
 	var m struct {
 		Generate bool
+		Methods  entproto.Method
 	}
 	if err := mapstructure.Decode(annot, &m); err != nil {
 		return nil, false, err
@@ -95,7 +275,40 @@ func protoSvc(annot schema.Annotation) (ast.Expr, bool, error) {
 	if !m.Generate {
 		return nil, false, nil
 	}
-	return fnCall(selectorLit("entproto", "Service")), true, nil
+	c := fnCall(selectorLit("entproto", "Service"))
+	if m.Methods != 0 && m.Methods != entproto.MethodAll {
+		var methods ast.Expr
+		for _, pm := range protoMethods {
+			if !m.Methods.Is(pm.method) {
+				continue
+			}
+			sel := selectorLit("entproto", pm.name)
+			if methods == nil {
+				methods = sel
+				continue
+			}
+			methods = &ast.BinaryExpr{X: methods, Op: token.OR, Y: sel}
+		}
+		c.Args = []ast.Expr{fnCall(selectorLit("entproto", "Methods"), methods)}
+	}
+	return c, true, nil
+}
+
+// protoMethods holds the names of the entproto.Method flags, in the order they are declared.
+var protoMethods = []struct {
+	method entproto.Method
+	name   string
+}{
+	{entproto.MethodCreate, "MethodCreate"},
+	{entproto.MethodGet, "MethodGet"},
+	{entproto.MethodUpdate, "MethodUpdate"},
+	{entproto.MethodDelete, "MethodDelete"},
+	{entproto.MethodList, "MethodList"},
+	{entproto.MethodBatchCreate, "MethodBatchCreate"},
+}
+
+func protoSkip(schema.Annotation) (ast.Expr, bool, error) {
+	return fnCall(selectorLit("entproto", "Skip")), true, nil
 }
 
 func protoField(annot schema.Annotation) (ast.Expr, bool, error) {
@@ -131,15 +344,20 @@ func protoEnum(annot schema.Annotation) (ast.Expr, bool, error) {
 			Value: ast.NewIdent("int32"),
 		},
 	}
-	for k, v := range m.Options {
+	keys := make([]string, 0, len(m.Options))
+	for k := range m.Options {
+		keys = append(keys, k)
+	}
+	// Sort the options by their numeric value, which is the order they appear in the generated .proto file.
+	sort.Slice(keys, func(i, j int) bool {
+		return m.Options[keys[i]] < m.Options[keys[j]]
+	})
+	for _, k := range keys {
 		opts.Elts = append(opts.Elts, &ast.KeyValueExpr{
 			Key:   strLit(k),
-			Value: intLit(int(v)),
+			Value: intLit(int(m.Options[k])),
 		})
 	}
-	sort.Slice(opts.Elts, func(i, j int) bool {
-		return opts.Elts[i].(*ast.KeyValueExpr).Value.(*ast.BasicLit).Value < opts.Elts[j].(*ast.KeyValueExpr).Value.(*ast.BasicLit).Value
-	})
 	return fnCall(selectorLit("entproto", "Enum"), opts), true, nil
 }
 
@@ -163,9 +381,15 @@ func entSQL(annot schema.Annotation) (ast.Expr, bool, error) {
 	if m.Default != "" {
 		c.Elts = append(c.Elts, structAttr("Default", strLit(m.Default)))
 	}
+	if m.Options != "" {
+		c.Elts = append(c.Elts, structAttr("Options", strLit(m.Options)))
+	}
 	if m.Size > 0 {
 		c.Elts = append(c.Elts, structAttr("Size", intLit(int(m.Size))))
 	}
+	if m.Incremental != nil {
+		c.Elts = append(c.Elts, structAttr("Incremental", boolPtr(*m.Incremental)))
+	}
 	if m.OnDelete != "" {
 		switch m.OnDelete {
 		case entsql.NoAction:
@@ -182,10 +406,232 @@ func entSQL(annot schema.Annotation) (ast.Expr, bool, error) {
 			return nil, false, fmt.Errorf("schemast: unknown entsql ReferenceOption: %q", m.OnDelete)
 		}
 	}
-	// TODO(rotemtam): support m.Incremental (it is a *bool)
+	if m.Check != "" {
+		c.Elts = append(c.Elts, structAttr("Check", strLit(m.Check)))
+	}
+	if len(m.Checks) > 0 {
+		c.Elts = append(c.Elts, structAttr("Checks", strMapLit(m.Checks)))
+	}
 	return c, true, nil
 }
 
+// entSQLIndex is the Annotator of entsql index annotations. An annotation that sets a single option is
+// rendered using the option function of the entsql package that creates it (e.g. entsql.IndexType), and
+// annotations that set several options are rendered as entsql.IndexAnnotation literals.
+func entSQLIndex(annot schema.Annotation) (ast.Expr, bool, error) {
+	m := &entsql.IndexAnnotation{}
+	if err := mapstructure.Decode(annot, m); err != nil {
+		return nil, false, err
+	}
+	var (
+		opts []ast.Expr
+		c    = &ast.CompositeLit{Type: selectorLit("entsql", "IndexAnnotation")}
+		// literal is set if one of the options cannot be created using an option function.
+		literal bool
+	)
+	if m.Prefix > 0 {
+		opts = append(opts, fnCall(selectorLit("entsql", "Prefix"), intLit(int(m.Prefix))))
+		c.Elts = append(c.Elts, structAttr("Prefix", intLit(int(m.Prefix))))
+	}
+	if len(m.PrefixColumns) > 0 {
+		cols, err := literalExpr(reflect.ValueOf(m.PrefixColumns))
+		if err != nil {
+			return nil, false, err
+		}
+		for name, prefix := range m.PrefixColumns {
+			opts = append(opts, fnCall(selectorLit("entsql", "PrefixColumn"), strLit(name), intLit(int(prefix))))
+		}
+		c.Elts = append(c.Elts, structAttr("PrefixColumns", cols))
+	}
+	if m.Desc {
+		opts = append(opts, fnCall(selectorLit("entsql", "Desc")))
+		c.Elts = append(c.Elts, structAttr("Desc", ast.NewIdent("true")))
+	}
+	if len(m.DescColumns) > 0 {
+		cols, err := literalExpr(reflect.ValueOf(m.DescColumns))
+		if err != nil {
+			return nil, false, err
+		}
+		names := make([]string, 0, len(m.DescColumns))
+		for name, desc := range m.DescColumns {
+			names = append(names, name)
+			// Columns that are explicitly set as ascending cannot be declared using DescColumns.
+			literal = literal || !desc
+		}
+		sort.Strings(names)
+		opts = append(opts, fnCall(selectorLit("entsql", "DescColumns"), strLits(names)...))
+		c.Elts = append(c.Elts, structAttr("DescColumns", cols))
+	}
+	if m.Type != "" {
+		opts = append(opts, fnCall(selectorLit("entsql", "IndexType"), strLit(m.Type)))
+		c.Elts = append(c.Elts, structAttr("Type", strLit(m.Type)))
+	}
+	if len(m.Types) > 0 {
+		opts = append(opts, fnCall(selectorLit("entsql", "IndexTypes"), strMapLit(m.Types)))
+		c.Elts = append(c.Elts, structAttr("Types", strMapLit(m.Types)))
+	}
+	if len(opts) == 1 && !literal {
+		return opts[0], true, nil
+	}
+	return c, true, nil
+}
+
+// fieldAnnotation is the Annotator of field.Annotation, which configures the fields of a type. Composite
+// identifiers of edge schemas are rendered using field.ID, e.g. field.ID("user_id", "group_id").
+func fieldAnnotation(annot schema.Annotation) (ast.Expr, bool, error) {
+	m := &field.Annotation{}
+	if err := mapstructure.Decode(annot, m); err != nil {
+		return nil, false, err
+	}
+	if len(m.StructTag) == 0 && len(m.ID) >= 2 {
+		return fnCall(selectorLit("field", "ID"), strLits(m.ID)...), true, nil
+	}
+	c := &ast.CompositeLit{Type: selectorLit("field", "Annotation")}
+	if len(m.StructTag) > 0 {
+		c.Elts = append(c.Elts, structAttr("StructTag", strMapLit(m.StructTag)))
+	}
+	if len(m.ID) > 0 {
+		c.Elts = append(c.Elts, structAttr("ID", &ast.CompositeLit{
+			Type: &ast.ArrayType{Elt: ast.NewIdent("string")},
+			Elts: strLits(m.ID),
+		}))
+	}
+	return c, true, nil
+}
+
+// boolPtr returns an expression of a pointer to b. A pointer to false is created using new(bool), and a
+// pointer to true is taken from a one-element slice literal, e.g. &[]bool{true}[0].
+func boolPtr(b bool) ast.Expr {
+	if !b {
+		return &ast.CallExpr{Fun: ast.NewIdent("new"), Args: []ast.Expr{ast.NewIdent("bool")}}
+	}
+	return &ast.UnaryExpr{
+		Op: token.AND,
+		X: &ast.IndexExpr{
+			X: &ast.CompositeLit{
+				Type: &ast.ArrayType{Elt: ast.NewIdent("bool")},
+				Elts: []ast.Expr{ast.NewIdent("true")},
+			},
+			Index: intLit(0),
+		},
+	}
+}
+
+// gqlSkipModes holds the names of the entgql.SkipMode flags, in the order they are declared.
+var gqlSkipModes = []struct {
+	mode entgql.SkipMode
+	name string
+}{
+	{entgql.SkipType, "SkipType"},
+	{entgql.SkipEnumField, "SkipEnumField"},
+	{entgql.SkipOrderField, "SkipOrderField"},
+	{entgql.SkipWhereInput, "SkipWhereInput"},
+	{entgql.SkipMutationCreateInput, "SkipMutationCreateInput"},
+	{entgql.SkipMutationUpdateInput, "SkipMutationUpdateInput"},
+}
+
+// entGQL is the Annotator of entgql annotations. Each annotation is expected to be created by one of the
+// option functions of the entgql package (e.g. entgql.OrderField), which is the call that is returned.
+func entGQL(annot schema.Annotation) (ast.Expr, bool, error) {
+	m := &entgql.Annotation{}
+	if err := m.Decode(annot); err != nil {
+		return nil, false, err
+	}
+	var calls []ast.Expr
+	if m.OrderField != "" {
+		calls = append(calls, fnCall(selectorLit("entgql", "OrderField"), strLit(m.OrderField)))
+	}
+	switch {
+	case len(m.Mapping) > 0:
+		calls = append(calls, fnCall(selectorLit("entgql", "MapsTo"), strLits(m.Mapping)...))
+	case m.Unbind:
+		calls = append(calls, fnCall(selectorLit("entgql", "Unbind")))
+	}
+	if m.Type != "" {
+		calls = append(calls, fnCall(selectorLit("entgql", "Type"), strLit(m.Type)))
+	}
+	if m.Skip.Any() {
+		c := fnCall(selectorLit("entgql", "Skip"))
+		if m.Skip != entgql.SkipAll {
+			for _, f := range gqlSkipModes {
+				if m.Skip.Is(f.mode) {
+					c.Args = append(c.Args, selectorLit("entgql", f.name))
+				}
+			}
+		}
+		calls = append(calls, c)
+	}
+	if m.RelayConnection {
+		calls = append(calls, fnCall(selectorLit("entgql", "RelayConnection")))
+	}
+	if len(m.Implements) > 0 {
+		calls = append(calls, fnCall(selectorLit("entgql", "Implements"), strLits(m.Implements)...))
+	}
+	if len(m.Directives) > 0 {
+		directives, err := gqlDirectives(m.Directives)
+		if err != nil {
+			return nil, false, err
+		}
+		calls = append(calls, fnCall(selectorLit("entgql", "Directives"), directives...))
+	}
+	if m.QueryField != nil {
+		var c ast.Expr = fnCall(selectorLit("entgql", "QueryField"))
+		if m.QueryField.Name != "" {
+			c.(*ast.CallExpr).Args = []ast.Expr{strLit(m.QueryField.Name)}
+		}
+		if m.QueryField.Description != "" {
+			c = fnCall(&ast.SelectorExpr{X: c, Sel: ast.NewIdent("Description")}, strLit(m.QueryField.Description))
+		}
+		if len(m.QueryField.Directives) > 0 {
+			directives, err := gqlDirectives(m.QueryField.Directives)
+			if err != nil {
+				return nil, false, err
+			}
+			c = fnCall(&ast.SelectorExpr{X: c, Sel: ast.NewIdent("Directives")}, directives...)
+		}
+		calls = append(calls, c)
+	}
+	if len(m.MutationInputs) > 0 {
+		c := fnCall(selectorLit("entgql", "Mutations"))
+		if len(m.MutationInputs) != 2 || !m.MutationInputs[0].IsCreate || m.MutationInputs[1].IsCreate {
+			for _, in := range m.MutationInputs {
+				if in.IsCreate {
+					c.Args = append(c.Args, fnCall(selectorLit("entgql", "MutationCreate")))
+				} else {
+					c.Args = append(c.Args, fnCall(selectorLit("entgql", "MutationUpdate")))
+				}
+			}
+		}
+		calls = append(calls, c)
+	}
+	switch len(calls) {
+	case 0:
+		// An empty annotation, such as the one returned by entgql.Bind, has no effect.
+		return nil, false, nil
+	case 1:
+		return calls[0], true, nil
+	default:
+		return nil, false, fmt.Errorf("schemast: entgql annotation sets %d options, expected a single option per annotation", len(calls))
+	}
+}
+
+// gqlDirectives returns the expressions of the entgql directives. Only the "deprecated" directive, created
+// by entgql.Deprecated, is currently supported.
+func gqlDirectives(directives []entgql.Directive) ([]ast.Expr, error) {
+	exprs := make([]ast.Expr, 0, len(directives))
+	for _, d := range directives {
+		if d.Name != "deprecated" || len(d.Arguments) > 1 || (len(d.Arguments) == 1 && d.Arguments[0].Name != "reason") {
+			return nil, fmt.Errorf("schemast: unsupported entgql directive %q", d.Name)
+		}
+		var reason string
+		if len(d.Arguments) == 1 {
+			reason = d.Arguments[0].Value
+		}
+		exprs = append(exprs, fnCall(selectorLit("entgql", "Deprecated"), strLit(reason)))
+	}
+	return exprs, nil
+}
+
 func toAnnotASTs(annots []schema.Annotation) ([]ast.Expr, error) {
 	out := make([]ast.Expr, 0, len(annots))
 	for _, annot := range annots {
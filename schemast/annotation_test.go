@@ -16,13 +16,19 @@ package schemast
 
 import (
 	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
 	"go/printer"
 	"go/token"
 	"testing"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entproto"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
@@ -86,6 +92,34 @@ func TestAnnotation(t *testing.T) {
 			expectedOk: true,
 			expected:   `entproto.Enum(map[string]int32{"unspecified": 0, "active": 1})`,
 		},
+		{
+			name: "proto enum numeric order",
+			annot: entproto.Enum(map[string]int32{
+				"ten":         10,
+				"two":         2,
+				"unspecified": 0,
+			}),
+			expectedOk: true,
+			expected:   `entproto.Enum(map[string]int32{"unspecified": 0, "two": 2, "ten": 10})`,
+		},
+		{
+			name:       "proto service methods",
+			annot:      entproto.Service(entproto.Methods(entproto.MethodGet | entproto.MethodCreate | entproto.MethodList)),
+			expectedOk: true,
+			expected:   `entproto.Service(entproto.Methods(entproto.MethodCreate | entproto.MethodGet | entproto.MethodList))`,
+		},
+		{
+			name:       "proto service all methods",
+			annot:      entproto.Service(entproto.Methods(entproto.MethodAll)),
+			expectedOk: true,
+			expected:   `entproto.Service()`,
+		},
+		{
+			name:       "proto skip field",
+			annot:      entproto.Skip(),
+			expectedOk: true,
+			expected:   `entproto.Skip()`,
+		},
 		{
 			name: "entsql annotation table",
 			annot: entsql.Annotation{
@@ -134,6 +168,51 @@ func TestAnnotation(t *testing.T) {
 			expectedOk: true,
 			expected:   `entsql.Annotation{OnDelete: entsql.NoAction}`,
 		},
+		{
+			name: "entsql annotation options",
+			annot: entsql.Annotation{
+				Options: "ENGINE = INNODB",
+			},
+			expectedOk: true,
+			expected:   `entsql.Annotation{Options: "ENGINE = INNODB"}`,
+		},
+		{
+			name: "entsql annotation incremental disabled",
+			annot: entsql.Annotation{
+				Incremental: new(bool),
+			},
+			expectedOk: true,
+			expected:   `entsql.Annotation{Incremental: new(bool)}`,
+		},
+		{
+			name: "entsql annotation incremental enabled",
+			annot: entsql.Annotation{
+				Incremental: &[]bool{true}[0],
+			},
+			expectedOk: true,
+			expected:   `entsql.Annotation{Incremental: &[]bool{true}[0]}`,
+		},
+		{
+			name: "entsql annotation checks",
+			annot: entsql.Annotation{
+				Check: "price > 0",
+				Checks: map[string]string{
+					"valid_discount": "discount < price",
+					"positive_price": "price > 0",
+				},
+			},
+			expectedOk: true,
+			expected:   `entsql.Annotation{Check: "price > 0", Checks: map[string]string{"positive_price": "price > 0", "valid_discount": "discount < price"}}`,
+		},
+		{
+			name: "entsql annotation pointer",
+			annot: &entsql.Annotation{
+				Charset:   "utf8mb4",
+				Collation: "utf8mb4_bin",
+			},
+			expectedOk: true,
+			expected:   `entsql.Annotation{Charset: "utf8mb4", Collation: "utf8mb4_bin"}`,
+		},
 		{
 			name: "entsql annotation unknown on delete",
 			annot: entsql.Annotation{
@@ -142,6 +221,160 @@ func TestAnnotation(t *testing.T) {
 			expectedOk:     false,
 			expectedErrMsg: `schemast: unknown entsql ReferenceOption: "UNSUPPORTED"`,
 		},
+		{
+			name:       "entsql index prefix",
+			annot:      entsql.Prefix(100),
+			expectedOk: true,
+			expected:   `entsql.Prefix(100)`,
+		},
+		{
+			name:       "entsql index prefix column",
+			annot:      entsql.PrefixColumn("name", 50),
+			expectedOk: true,
+			expected:   `entsql.PrefixColumn("name", 50)`,
+		},
+		{
+			name:       "entsql index desc",
+			annot:      entsql.Desc(),
+			expectedOk: true,
+			expected:   `entsql.Desc()`,
+		},
+		{
+			name:       "entsql index desc columns",
+			annot:      entsql.DescColumns("b", "a"),
+			expectedOk: true,
+			expected:   `entsql.DescColumns("a", "b")`,
+		},
+		{
+			name:       "entsql index asc and desc columns",
+			annot:      &entsql.IndexAnnotation{DescColumns: map[string]bool{"a": true, "b": false}},
+			expectedOk: true,
+			expected:   `entsql.IndexAnnotation{DescColumns: map[string]bool{"a": true, "b": false}}`,
+		},
+		{
+			name:       "entsql index type",
+			annot:      entsql.IndexType("GIN"),
+			expectedOk: true,
+			expected:   `entsql.IndexType("GIN")`,
+		},
+		{
+			name:       "entsql index types",
+			annot:      entsql.IndexTypes(map[string]string{dialect.MySQL: "FULLTEXT", dialect.Postgres: "GIN"}),
+			expectedOk: true,
+			expected:   `entsql.IndexTypes(map[string]string{"mysql": "FULLTEXT", "postgres": "GIN"})`,
+		},
+		{
+			name: "entsql index options",
+			annot: entsql.IndexAnnotation{
+				PrefixColumns: map[string]uint{"title": 10, "body": 20},
+				Type:          "FULLTEXT",
+			},
+			expectedOk: true,
+			expected:   `entsql.IndexAnnotation{PrefixColumns: map[string]uint{"body": 20, "title": 10}, Type: "FULLTEXT"}`,
+		},
+		{
+			name:       "field composite id",
+			annot:      field.ID("user_id", "group_id"),
+			expectedOk: true,
+			expected:   `field.ID("user_id", "group_id")`,
+		},
+		{
+			name: "field struct tags",
+			annot: field.Annotation{
+				StructTag: map[string]string{"id": `json:"id,omitempty"`},
+				ID:        []string{"user_id", "group_id"},
+			},
+			expectedOk: true,
+			expected:   `field.Annotation{StructTag: map[string]string{"id": "json:\"id,omitempty\""}, ID: []string{"user_id", "group_id"}}`,
+		},
+		{
+			name:       "entgql order field",
+			annot:      entgql.OrderField("CREATED_AT"),
+			expectedOk: true,
+			expected:   `entgql.OrderField("CREATED_AT")`,
+		},
+		{
+			name:       "entgql relay connection",
+			annot:      entgql.RelayConnection(),
+			expectedOk: true,
+			expected:   `entgql.RelayConnection()`,
+		},
+		{
+			name:       "entgql query field",
+			annot:      entgql.QueryField(),
+			expectedOk: true,
+			expected:   `entgql.QueryField()`,
+		},
+		{
+			name:       "entgql query field with options",
+			annot:      entgql.QueryField("allTodos").Description("All todos.").Directives(entgql.Deprecated("Use todos.")),
+			expectedOk: true,
+			expected:   `entgql.QueryField("allTodos").Description("All todos.").Directives(entgql.Deprecated("Use todos."))`,
+		},
+		{
+			name:       "entgql skip all",
+			annot:      entgql.Skip(),
+			expectedOk: true,
+			expected:   `entgql.Skip()`,
+		},
+		{
+			name:       "entgql skip modes",
+			annot:      entgql.Skip(entgql.SkipWhereInput | entgql.SkipType),
+			expectedOk: true,
+			expected:   `entgql.Skip(entgql.SkipType, entgql.SkipWhereInput)`,
+		},
+		{
+			name:       "entgql type",
+			annot:      entgql.Type("TodoStatus"),
+			expectedOk: true,
+			expected:   `entgql.Type("TodoStatus")`,
+		},
+		{
+			name:       "entgql maps to",
+			annot:      entgql.MapsTo("parent", "owner"),
+			expectedOk: true,
+			expected:   `entgql.MapsTo("parent", "owner")`,
+		},
+		{
+			name:       "entgql unbind",
+			annot:      entgql.Unbind(),
+			expectedOk: true,
+			expected:   `entgql.Unbind()`,
+		},
+		{
+			name:       "entgql implements",
+			annot:      entgql.Implements("Entity", "Named"),
+			expectedOk: true,
+			expected:   `entgql.Implements("Entity", "Named")`,
+		},
+		{
+			name:       "entgql directives",
+			annot:      entgql.Directives(entgql.Deprecated("Use description.")),
+			expectedOk: true,
+			expected:   `entgql.Directives(entgql.Deprecated("Use description."))`,
+		},
+		{
+			name:       "entgql mutations",
+			annot:      entgql.Mutations(),
+			expectedOk: true,
+			expected:   `entgql.Mutations()`,
+		},
+		{
+			name:       "entgql mutation create",
+			annot:      entgql.Mutations(entgql.MutationCreate()),
+			expectedOk: true,
+			expected:   `entgql.Mutations(entgql.MutationCreate())`,
+		},
+		{
+			name:           "entgql multiple options",
+			annot:          entgql.Annotation{OrderField: "NAME", Type: "Name"},
+			expectedErrMsg: `schemast: entgql annotation sets 2 options, expected a single option per annotation`,
+		},
+		{
+			name:           "entgql unsupported directive",
+			annot:          entgql.Directives(entgql.NewDirective("custom")),
+			expectedErrMsg: `schemast: unsupported entgql directive "custom"`,
+		},
 		{
 			name:           "unsupported annotation",
 			annot:          annotation("unsupported"),
@@ -168,6 +401,130 @@ func TestAnnotation(t *testing.T) {
 	}
 }
 
+type audit struct {
+	Enabled bool
+}
+
+func (audit) Name() string { return "Audit" }
+
+func TestRegisterAnnotation(t *testing.T) {
+	_, _, err := Annotation(audit{Enabled: true})
+	require.EqualError(t, err, `schemast: no Annotator configured for annotation "Audit"`)
+
+	err = RegisterAnnotation("Audit", func(annot schema.Annotation) (ast.Expr, error) {
+		switch a := annot.(type) {
+		case audit:
+			if !a.Enabled {
+				return nil, nil
+			}
+			return fnCall(selectorLit("audit", "Enabled")), nil
+		default:
+			return nil, fmt.Errorf("unexpected annotation %T", annot)
+		}
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		annotators.Lock()
+		delete(annotators.m, "Audit")
+		annotators.Unlock()
+	})
+
+	r, ok, err := Annotation(audit{Enabled: true})
+	require.NoError(t, err)
+	require.True(t, ok)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+	require.EqualValues(t, `audit.Enabled()`, buf.String())
+
+	_, ok, err = Annotation(audit{})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, _, err = Annotation(annotationName("Audit"))
+	require.EqualError(t, err, `unexpected annotation schemast.annotationName`)
+
+	exprs, err := toAnnotASTs([]schema.Annotation{entproto.Field(1), audit{Enabled: true}, audit{}})
+	require.NoError(t, err)
+	require.Len(t, exprs, 2)
+
+	require.EqualError(t, RegisterAnnotation("", nil), "schemast: expected an annotation name and a func to register")
+}
+
+type tagsAnnotation struct {
+	Tags   []string
+	Weight float64
+	Props  map[string]interface{}
+	Level  level
+	Nested *tagsAnnotation
+	hidden string
+}
+
+func (tagsAnnotation) Name() string { return "Tags" }
+
+func TestReflectedAnnotations(t *testing.T) {
+	annot := tagsAnnotation{
+		Tags:   []string{"a", "b"},
+		Weight: 1,
+		Props:  map[string]interface{}{"x": level(2), "n": 1, "ok": true},
+		Nested: &tagsAnnotation{Level: 3},
+	}
+	_, _, err := Annotation(annot)
+	require.EqualError(t, err, `schemast: no Annotator configured for annotation "Tags"`)
+	require.Empty(t, annotationImports([]schema.Annotation{annot}))
+
+	UseReflectedAnnotations(true)
+	t.Cleanup(func() {
+		UseReflectedAnnotations(false)
+	})
+	tests := []struct {
+		name           string
+		annot          schema.Annotation
+		expected       string
+		expectedErrMsg string
+	}{
+		{
+			name:     "struct",
+			annot:    annot,
+			expected: `schemast.tagsAnnotation{Tags: []string{"a", "b"}, Weight: 1.0, Props: map[string]interface{}{"n": 1, "ok": true, "x": schemast.level(2)}, Nested: &schemast.tagsAnnotation{Level: 3}}`,
+		},
+		{
+			name:     "pointer",
+			annot:    &tagsAnnotation{Tags: []string{}},
+			expected: `&schemast.tagsAnnotation{Tags: []string{}}`,
+		},
+		{
+			name:           "unexported field",
+			annot:          tagsAnnotation{hidden: "x"},
+			expectedErrMsg: `schemast: unsupported literal of type schemast.tagsAnnotation with unexported field hidden`,
+		},
+		{
+			name:           "not a struct",
+			annot:          annotation("unsupported"),
+			expectedErrMsg: `schemast: no Annotator configured for annotation "unsupported"`,
+		},
+		{
+			name:     "registered",
+			annot:    entproto.Field(1),
+			expected: `entproto.Field(1)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, ok, err := Annotation(tt.annot)
+			if tt.expectedErrMsg != "" {
+				require.EqualError(t, err, tt.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, ok)
+			var buf bytes.Buffer
+			require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+			require.EqualValues(t, tt.expected, buf.String())
+		})
+	}
+	require.EqualValues(t, []string{"entgo.io/contrib/schemast"}, annotationImports([]schema.Annotation{entproto.Field(1), annot}))
+}
+
 func TestContext_AnnotateType(t *testing.T) {
 	tt, err := newPrintTest(t)
 	require.NoError(t, err)
@@ -200,3 +557,40 @@ func TestContext_AnnotateTypeExisting(t *testing.T) {
 	return []schema.Annotation{entproto.Message()}
 }`)
 }
+
+func TestContext_RemoveTypeAnnotation(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendTypeAnnotation("Message", entsql.Annotation{Table: "messages", Charset: "utf8mb4"}))
+	require.NoError(t, tt.ctx.AppendTypeAnnotation("Message", entproto.Message()))
+
+	err = tt.ctx.RemoveTypeAnnotation("Message", entsql.Annotation{Table: "messages"})
+	require.EqualError(t, err, `schemast: could not find annotation "EntSQL" in type "Message"`)
+	require.NoError(t, tt.ctx.RemoveTypeAnnotation("Message", entsql.Annotation{Table: "messages", Charset: "utf8mb4"}))
+	err = tt.ctx.RemoveTypeAnnotation("Message", entsql.Annotation{Table: "messages", Charset: "utf8mb4"})
+	require.EqualError(t, err, `schemast: could not find annotation "EntSQL" in type "Message"`)
+	err = tt.ctx.RemoveTypeAnnotation("Message", annotation("unsupported"))
+	require.EqualError(t, err, `schemast: no Annotator configured for annotation "unsupported"`)
+
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	message := tt.getType("Message")
+	require.Len(t, message.Annotations, 1)
+	require.Contains(t, message.Annotations, entproto.MessageAnnotation)
+	require.Contains(t, tt.contents("message.go"), `func (Message) Annotations() []schema.Annotation {
+	return []schema.Annotation{entproto.Message()}
+}`)
+}
+
+func TestExprString(t *testing.T) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "a.go", "package a\nvar a = entsql.Annotation{\n\tTable:   \"users\",\n\tCharset: \"utf8mb4\",\n}\n", 0)
+	require.NoError(t, err)
+	s, err := exprString(f.Decls[0].(*ast.GenDecl).Specs[0].(*ast.ValueSpec).Values[0])
+	require.NoError(t, err)
+	expr, _, err := Annotation(entsql.Annotation{Table: "users", Charset: "utf8mb4"})
+	require.NoError(t, err)
+	expected, err := exprString(expr)
+	require.NoError(t, err)
+	require.EqualValues(t, expected, s)
+}
@@ -15,11 +15,15 @@
 package schemast
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
+	"go/parser"
+	"go/printer"
 	"go/token"
 	"sort"
 	"strconv"
+	"strings"
 
 	"go.uber.org/multierr"
 )
@@ -76,6 +80,14 @@ func strLit(lit string) ast.Expr {
 	}
 }
 
+func strLits(lits []string) []ast.Expr {
+	exprs := make([]ast.Expr, 0, len(lits))
+	for _, lit := range lits {
+		exprs = append(exprs, strLit(lit))
+	}
+	return exprs
+}
+
 func structAttr(name string, val ast.Expr) ast.Expr {
 	return &ast.KeyValueExpr{
 		Key: &ast.BasicLit{
@@ -139,7 +151,10 @@ func sliceWith(sel *ast.SelectorExpr, exprs ...ast.Expr) *ast.CompositeLit {
 }
 
 func (c *Context) appendMethod(typeName, method string, retType *ast.SelectorExpr) error {
-	file, _, _ := c.lookupTypeDecl(typeName)
+	file, _, ok := c.lookupTypeDecl(typeName)
+	if !ok {
+		return fmt.Errorf("schemast: type %q not found", typeName)
+	}
 	fd := &ast.FuncDecl{
 		Name: ast.NewIdent(method),
 		Type: &ast.FuncType{
@@ -155,9 +170,7 @@ func (c *Context) appendMethod(typeName, method string, retType *ast.SelectorExp
 			},
 		},
 		Recv: &ast.FieldList{
-			List: []*ast.Field{
-				{Type: ast.NewIdent(typeName)},
-			},
+			List: []*ast.Field{c.receiver(typeName)},
 		},
 		Body: &ast.BlockStmt{
 			List: []ast.Stmt{
@@ -170,3 +183,80 @@ func (c *Context) appendMethod(typeName, method string, retType *ast.SelectorExp
 	file.Decls = append(file.Decls, fd)
 	return nil
 }
+
+func (c *Context) receiver(typeName string) *ast.Field {
+	recv := &ast.Field{Type: ast.NewIdent(typeName)}
+	if name := c.receiverName(typeName); name != "" {
+		recv.Names = []*ast.Ident{ast.NewIdent(name)}
+	}
+	return recv
+}
+
+// reparse prints file and parses it again with its comments, such that nodes added by mutations are
+// assigned positions. The parsed file replaces file in the Context, and is returned along with its source.
+func (c *Context) reparse(file *ast.File) (*ast.File, []byte, error) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, c.SchemaPackage.Fset, file); err != nil {
+		return nil, nil, err
+	}
+	return c.replaceSource(file, buf.Bytes())
+}
+
+// replaceSource parses src as the new contents of file, and replaces file in the Context with the result.
+func (c *Context) replaceSource(file *ast.File, src []byte) (*ast.File, []byte, error) {
+	name := c.SchemaPackage.Fset.File(file.Pos()).Name()
+	parsed, err := parser.ParseFile(c.SchemaPackage.Fset, name, src, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, f := range c.SchemaPackage.Syntax {
+		if f == file {
+			c.SchemaPackage.Syntax[i] = parsed
+		}
+	}
+	for typeName, f := range c.newTypes {
+		if f == file {
+			c.newTypes[typeName] = parsed
+		}
+	}
+	return parsed, src, nil
+}
+
+// appendDeclSource adds src, the source of top-level declarations, to the end of the file that declares the
+// type typeName, and replaces the file in the Context with the parsed result.
+func (c *Context) appendDeclSource(typeName, src string) error {
+	file, _, ok := c.lookupTypeDecl(typeName)
+	if !ok {
+		return fmt.Errorf("schemast: type %q not found", typeName)
+	}
+	file, content, err := c.reparse(file)
+	if err != nil {
+		return err
+	}
+	_, _, err = c.replaceSource(file, append(content, "\n"+strings.TrimSpace(src)+"\n"...))
+	return err
+}
+
+// offset returns the offset of pos in the file it belongs to.
+func (c *Context) offset(pos token.Pos) int {
+	return c.SchemaPackage.Fset.Position(pos).Offset
+}
+
+// line returns the line of pos in the file it belongs to.
+func (c *Context) line(pos token.Pos) int {
+	return c.SchemaPackage.Fset.Position(pos).Line
+}
+
+// methodDecl returns the declaration of method methodName of type typeName in file.
+func methodDecl(file *ast.File, typeName, methodName string) (*ast.FuncDecl, bool) {
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || len(fd.Recv.List) != 1 || fd.Name.Name != methodName {
+			continue
+		}
+		if id, ok := fd.Recv.List[0].Type.(*ast.Ident); ok && id.Name == typeName {
+			return fd, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,184 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Cache caches the packages loaded by Load, such that loading a package again with the same options does not
+// run the go command or type-check the package if none of its files changed since it was cached. Only the
+// files of the package are parsed again, since the Context modifies their syntax trees. Changes to the
+// dependencies of a package are not detected. A Cache is safe for concurrent use.
+//
+//	cache := schemast.NewCache()
+//	ctx, err := schemast.Load("./ent/schema", schemast.WithCache(cache))
+type Cache struct {
+	mu   sync.Mutex
+	pkgs map[string]*cachedPackage
+}
+
+// cachedPackage is a package loaded by the go command along with the hashes of the files in its directory at
+// the time it was loaded. The Syntax of the package is not set.
+type cachedPackage struct {
+	pkg    *packages.Package
+	dir    string
+	files  []string
+	hashes map[string][sha256.Size]byte
+}
+
+// NewCache returns a new empty Cache.
+func NewCache() *Cache {
+	return &Cache{pkgs: make(map[string]*cachedPackage)}
+}
+
+// WithCache modifies Load to use cache to load the schema package and the packages added with WithPackages.
+// The packages loaded from cache do not have their TypesInfo set, as it refers to the syntax trees it was
+// computed for, and the positions of their Types refer to the file set of the first load.
+func WithCache(cache *Cache) LoadOption {
+	return func(opts *loadOpts) {
+		opts.cache = cache
+	}
+}
+
+func (c *Cache) load(path string, opts *loadOpts) (*packages.Package, error) {
+	key, err := cacheKey(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	cached, ok := c.pkgs[key]
+	c.mu.Unlock()
+	if ok {
+		srcs, err := packageSources(cached.dir, opts)
+		if err == nil && sameHashes(cached.hashes, srcs) {
+			return cached.parse(srcs)
+		}
+	}
+	pkg, err := listPackage(path, opts)
+	if err != nil || len(pkg.Syntax) == 0 {
+		return pkg, err
+	}
+	files := make([]string, len(pkg.Syntax))
+	for i, f := range pkg.Syntax {
+		files[i] = pkg.Fset.Position(f.Package).Filename
+	}
+	dir := filepath.Dir(files[0])
+	srcs, err := packageSources(dir, opts)
+	if err != nil {
+		// The package is still usable, it is just not cached.
+		return pkg, nil
+	}
+	stored := *pkg
+	stored.Syntax = nil
+	stored.TypesInfo = nil
+	cached = &cachedPackage{pkg: &stored, dir: dir, files: files, hashes: make(map[string][sha256.Size]byte, len(srcs))}
+	for name, src := range srcs {
+		cached.hashes[name] = sha256.Sum256(src)
+	}
+	c.mu.Lock()
+	c.pkgs[key] = cached
+	c.mu.Unlock()
+	return pkg, nil
+}
+
+// parse returns a copy of the cached package with its files parsed from srcs. The files are parsed into a new
+// file set, such that the file set of the cached package does not grow with every load.
+func (p *cachedPackage) parse(srcs map[string][]byte) (*packages.Package, error) {
+	pkg := *p.pkg
+	pkg.Fset = token.NewFileSet()
+	pkg.Syntax = make([]*ast.File, 0, len(p.files))
+	for _, name := range p.files {
+		f, err := parser.ParseFile(pkg.Fset, name, srcs[name], parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		pkg.Syntax = append(pkg.Syntax, f)
+	}
+	return &pkg, nil
+}
+
+// cacheKey returns the key of the package at path loaded with opts in a Cache.
+func cacheKey(path string, opts *loadOpts) (string, error) {
+	dir, err := filepath.Abs(opts.dir)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s\x00%s\x00%q\x00%q\x00%t\x00%t", path, dir, opts.buildFlags, opts.env, opts.tests, opts.syntaxOnly), nil
+}
+
+// packageSources returns the contents of the .go files of dir that may belong to the package loaded with opts,
+// including the ones excluded by build constraints, keyed by their absolute paths. The files of the overlay of
+// opts replace the files of dir.
+func packageSources(dir string, opts *loadOpts) (map[string][]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	overlay := make(map[string][]byte, len(opts.overlay))
+	for name, src := range opts.overlay {
+		abs, err := filepath.Abs(name)
+		if err != nil {
+			return nil, err
+		}
+		overlay[abs] = src
+	}
+	srcs := make(map[string][]byte)
+	for _, e := range entries {
+		name := filepath.Join(dir, e.Name())
+		if _, ok := overlay[name]; ok || !isPackageFile(name, opts) {
+			continue
+		}
+		src, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		srcs[name] = src
+	}
+	for name, src := range overlay {
+		if filepath.Dir(name) == dir && isPackageFile(name, opts) {
+			srcs[name] = src
+		}
+	}
+	return srcs, nil
+}
+
+// isPackageFile reports whether the file name may belong to a package loaded with opts.
+func isPackageFile(name string, opts *loadOpts) bool {
+	return strings.HasSuffix(name, ".go") && (opts.tests || !strings.HasSuffix(name, "_test.go"))
+}
+
+// sameHashes reports whether srcs holds the same files as hashes, with the same contents.
+func sameHashes(hashes map[string][sha256.Size]byte, srcs map[string][]byte) bool {
+	if len(hashes) != len(srcs) {
+		return false
+	}
+	for name, src := range srcs {
+		if h, ok := hashes[name]; !ok || h != sha256.Sum256(src) {
+			return false
+		}
+	}
+	return true
+}
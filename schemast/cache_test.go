@@ -0,0 +1,69 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	dir, err := os.MkdirTemp(".", "cachetest-")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	src, err := os.ReadFile("./internal/loadtest/ent/schema/message.go")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "message.go"), src, 0600))
+
+	cache := NewCache()
+	ctx, err := Load(dir, WithCache(cache))
+	require.NoError(t, err)
+	require.NotNil(t, ctx.SchemaPackage.TypesInfo)
+	require.NoError(t, ctx.AddType("Pet"))
+	require.NoError(t, ctx.AppendField("Message", field.String("title").Descriptor()))
+
+	cached, err := Load(dir, WithCache(cache))
+	require.NoError(t, err)
+	require.Same(t, ctx.SchemaPackage.Types, cached.SchemaPackage.Types)
+	require.Nil(t, cached.SchemaPackage.TypesInfo)
+	require.NotSame(t, ctx.SchemaPackage.Fset, cached.SchemaPackage.Fset)
+	again, err := Load(dir, WithCache(cache))
+	require.NoError(t, err)
+	require.Equal(t, cached.SchemaPackage.Fset.Base(), again.SchemaPackage.Fset.Base())
+	require.False(t, cached.HasType("Pet"))
+	require.False(t, cached.HasField("Message", "title"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pet.go"), []byte(`package schema
+
+import "entgo.io/ent"
+
+type Pet struct {
+	ent.Schema
+}
+`), 0600))
+	reloaded, err := Load(dir, WithCache(cache))
+	require.NoError(t, err)
+	require.NotSame(t, ctx.SchemaPackage.Types, reloaded.SchemaPackage.Types)
+	require.True(t, reloaded.HasType("Pet"))
+	require.NoError(t, cached.Reload())
+	require.Same(t, reloaded.SchemaPackage.Types, cached.SchemaPackage.Types)
+	require.True(t, cached.HasType("Pet"))
+}
@@ -19,6 +19,7 @@ import (
 	"go/ast"
 	"go/token"
 	"strconv"
+	"strings"
 
 	"entgo.io/ent/schema/edge"
 )
@@ -26,10 +27,25 @@ import (
 // Edge converts a *edge.Descriptor back into an *ast.CallExpr of the ent edge package that can be used
 // to construct it.
 func Edge(desc *edge.Descriptor) (*ast.CallExpr, error) {
-	builder := newEdgeCall(desc)
+	var builder *builderCall
+	if desc.Inverse && desc.Ref != nil {
+		// Edges of the same type defined using edge.To(...).From(...).
+		ref, err := Edge(desc.Ref)
+		if err != nil {
+			return nil, err
+		}
+		builder = &builderCall{curr: ref}
+		builder.method("From", strLit(desc.Name))
+	} else {
+		builder = newEdgeCall(desc)
+	}
 	if desc.RefName != "" {
 		builder.method("Ref", strLit(desc.RefName))
 	}
+	if desc.Through != nil {
+		// M2M edges that go through an edge schema, e.g. Through("likes", Like.Type).
+		builder.method("Through", strLit(desc.Through.N), selectorLit(desc.Through.T, "Type"))
+	}
 	if desc.Required {
 		builder.method("Required")
 	}
@@ -40,13 +56,21 @@ func Edge(desc *edge.Descriptor) (*ast.CallExpr, error) {
 		builder.method("Field", strLit(desc.Field))
 	}
 	if desc.StorageKey != nil {
-		tbl := fnCall(selectorLit("edge", "Table"), strLit(desc.StorageKey.Table))
-		col := fnCall(selectorLit("edge", "Column"), strLit(desc.StorageKey.Columns[0]))
-		if len(desc.StorageKey.Columns) == 2 {
-			to, from := strLit(desc.StorageKey.Columns[0]), strLit(desc.StorageKey.Columns[1])
-			col = fnCall(selectorLit("edge", "Columns"), to, from)
+		var opts []ast.Expr
+		if desc.StorageKey.Table != "" {
+			opts = append(opts, fnCall(selectorLit("edge", "Table"), strLit(desc.StorageKey.Table)))
+		}
+		switch cols := desc.StorageKey.Columns; len(cols) {
+		case 1:
+			opts = append(opts, fnCall(selectorLit("edge", "Column"), strLit(cols[0])))
+		case 2:
+			// M2M edges are keyed by the two columns of their join table, in order.
+			opts = append(opts, fnCall(selectorLit("edge", "Columns"), strLit(cols[0]), strLit(cols[1])))
 		}
-		builder.method("StorageKey", tbl, col)
+		builder.method("StorageKey", opts...)
+	}
+	if desc.Comment != "" {
+		builder.method("Comment", strLit(desc.Comment))
 	}
 	if desc.Tag != "" {
 		builder.method("StructTag", strLit(desc.Tag))
@@ -63,11 +87,192 @@ func Edge(desc *edge.Descriptor) (*ast.CallExpr, error) {
 
 // AppendEdge adds an edge to the returned values of the Edges method of type typeName.
 func (c *Context) AppendEdge(typeName string, desc *edge.Descriptor) error {
+	_, err := c.AppendEdgeExpr(typeName, desc)
+	return err
+}
+
+// AppendEdgeExpr is like AppendEdge, but also returns the expression that was added to the Edges method.
+func (c *Context) AppendEdgeExpr(typeName string, desc *edge.Descriptor) (ast.Expr, error) {
 	newEdge, err := Edge(desc)
+	if err != nil {
+		return nil, err
+	}
+	added, err := c.appendReturnItem(kindEdge, typeName, newEdge)
+	if err != nil {
+		return nil, err
+	}
+	c.appendEdgeImports(typeName, desc)
+	return added, nil
+}
+
+// appendEdgeImports imports the packages referenced by the AST that Edge generates for desc, including the
+// Packages of its qualified types, in the file of the Edges method of type typeName.
+func (c *Context) appendEdgeImports(typeName string, desc *edge.Descriptor) {
+	refs := []string{desc.Type}
+	if desc.Through != nil {
+		refs = append(refs, desc.Through.T)
+	}
+	c.appendImports(c.methodFile(typeName, kindEdge.methodName), append(edgeImports(desc), c.refImports(refs...)...))
+}
+
+// edgeImports returns the import paths of the packages referenced by the AST that Edge generates for desc.
+func edgeImports(desc *edge.Descriptor) []string {
+	paths := annotationImports(desc.Annotations)
+	if desc.Inverse && desc.Ref != nil {
+		paths = append(paths, edgeImports(desc.Ref)...)
+	}
+	return paths
+}
+
+// UpdateEdge replaces the edge named edgeName in the Edges method of type typeName with the edge described
+// by desc, keeping its position among the other edges of the type.
+func (c *Context) UpdateEdge(typeName, edgeName string, desc *edge.Descriptor) error {
+	stmt, err := c.returnStmt(typeName, "Edges")
 	if err != nil {
 		return err
 	}
-	return c.appendReturnItem(kindEdge, typeName, newEdge)
+	returned, ok := stmt.Results[0].(*ast.CompositeLit)
+	if !ok {
+		return fmt.Errorf("schemast: unexpected AST component type %T", stmt.Results[0])
+	}
+	index, exists := -1, false
+	for i, item := range returned.Elts {
+		call, ok := item.(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+		name, err := extractEdgeName(call)
+		if err != nil {
+			continue
+		}
+		switch name {
+		case edgeName:
+			index = i
+		case desc.Name:
+			exists = true
+		}
+	}
+	if index == -1 {
+		return fmt.Errorf("schemast: could not find edge %q in type %q", edgeName, typeName)
+	}
+	if exists {
+		return fmt.Errorf("schemast: edge %q already exists in type %q", desc.Name, typeName)
+	}
+	newEdge, err := Edge(desc)
+	if err != nil {
+		return err
+	}
+	returned.Elts[index] = newEdge
+	c.appendEdgeImports(typeName, desc)
+	return nil
+}
+
+// EdgeDiagnostic describes a problem found by CheckEdges in the declaration of an edge.
+type EdgeDiagnostic struct {
+	EdgeRef
+	// Pos is the position of the edge declaration. It is invalid for edges that were added to the Context
+	// and were not printed and loaded since.
+	Pos     token.Position
+	Message string
+}
+
+// String formats the diagnostic as "position: Type.Edge: message".
+func (d EdgeDiagnostic) String() string {
+	return fmt.Sprintf("%s: %s.%s: %s", d.Pos, d.Type, d.Edge, d.Message)
+}
+
+// CheckEdges reports the edges of the Context that reference types that are not declared in the Context,
+// inverse edges whose Ref does not match an edge of their target type, and edges bound to fields with a
+// Field call that are not unique or whose Required configuration does not match the field.
+func (c *Context) CheckEdges() ([]EdgeDiagnostic, error) {
+	var diags []EdgeDiagnostic
+	for _, typeName := range c.schemaTypes() {
+		if _, ok := c.lookupMethod(typeName, "Edges"); !ok {
+			continue
+		}
+		calls, err := c.edgeCalls(typeName)
+		if err != nil {
+			return nil, err
+		}
+		for _, call := range calls {
+			name, err := extractEdgeName(call)
+			if err != nil {
+				return nil, err
+			}
+			report := func(format string, args ...interface{}) {
+				diags = append(diags, EdgeDiagnostic{
+					EdgeRef: EdgeRef{Type: typeName, Edge: name},
+					Pos:     c.SchemaPackage.Fset.Position(call.Pos()),
+					Message: fmt.Sprintf(format, args...),
+				})
+			}
+			constructor := constructorCall(call)
+			target, ok := edgeTarget(constructor)
+			if !ok {
+				continue
+			}
+			if !c.hasTypeRef(target) {
+				report("type %q is not declared", target)
+				continue
+			}
+			if strings.Contains(target, ".") {
+				// The edges of types of other packages are not checked.
+				continue
+			}
+			if hasBuilderCall(call, "From") {
+				// Assoc and inverse edges of the same type declared using edge.To(...).From(...).
+				continue
+			}
+			if methodName(constructor) == "From" {
+				if ref, ok := stringArg(call, "Ref"); ok && !c.hasAssocEdge(target, typeName, ref) {
+					report("reference %q does not match an edge.To of type %q to type %q", ref, target, typeName)
+				}
+			}
+			fieldName, ok := stringArg(call, "Field")
+			if !ok {
+				continue
+			}
+			if !hasBuilderCall(call, "Unique") {
+				report("edge bound to field %q must be unique", fieldName)
+			}
+			fd, ok := c.effectiveField(typeName, fieldName)
+			if !ok {
+				report("bound field %q is not declared", fieldName)
+				continue
+			}
+			if required, optional := hasBuilderCall(call, "Required"), hasBuilderCall(fd, "Optional"); required == optional {
+				report("edge is required=%t but bound field %q is optional=%t", required, fieldName, optional)
+			}
+		}
+	}
+	return diags, nil
+}
+
+// hasAssocEdge reports whether type typeName declares an edge named edgeName to the type refType using edge.To.
+func (c *Context) hasAssocEdge(typeName, refType, edgeName string) bool {
+	call, err := c.lookupEdge(typeName, edgeName)
+	if err != nil {
+		return false
+	}
+	constructor := constructorCall(call)
+	target, ok := edgeTarget(constructor)
+	return ok && target == refType && methodName(constructor) == "To"
+}
+
+// stringArg returns the string literal passed to the first call to the method name in the builder chain of call.
+func stringArg(call *ast.CallExpr, name string) (string, bool) {
+	for _, b := range builderCalls(call) {
+		if methodName(b) != name || len(b.Args) != 1 {
+			continue
+		}
+		lit, ok := b.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return "", false
+		}
+		v, err := strconv.Unquote(lit.Value)
+		return v, err == nil
+	}
+	return "", false
 }
 
 // RemoveEdge removes an edge from the returned values of the Edges method of type typeName.
@@ -97,6 +302,116 @@ func (c *Context) RemoveEdge(typeName string, edgeName string) error {
 	return fmt.Errorf("schemast: could not find edge %q in type %q", edgeName, typeName)
 }
 
+// RenameEdge renames the edge oldName of type typeName to newName. If the edge is an assoc edge (declared using
+// edge.To), the Ref calls of the inverse edges that reference it in its target type are renamed as well.
+func (c *Context) RenameEdge(typeName, oldName, newName string) error {
+	call, err := c.lookupEdge(typeName, oldName)
+	if err != nil {
+		return err
+	}
+	if _, err := c.lookupEdge(typeName, newName); err == nil {
+		return fmt.Errorf("schemast: edge %q already exists in type %q", newName, typeName)
+	}
+	constructor := constructorCall(call)
+	constructor.Args[0] = strLit(newName)
+	target, ok := edgeTarget(constructor)
+	if methodName(constructor) != "To" || !ok {
+		return nil
+	}
+	if _, ok := c.lookupMethod(target, "Edges"); !ok {
+		return nil
+	}
+	calls, err := c.edgeCalls(target)
+	if err != nil {
+		return err
+	}
+	for _, inv := range calls {
+		if t, ok := edgeTarget(constructorCall(inv)); !ok || t != typeName {
+			continue
+		}
+		if ref, ok := refCall(inv, oldName); ok {
+			ref.Args[0] = strLit(newName)
+		}
+	}
+	return nil
+}
+
+// lookupEdge returns the expression declaring the edge named edgeName in the Edges method of type typeName.
+func (c *Context) lookupEdge(typeName, edgeName string) (*ast.CallExpr, error) {
+	calls, err := c.edgeCalls(typeName)
+	if err != nil {
+		return nil, err
+	}
+	for _, call := range calls {
+		if name, err := extractEdgeName(call); err == nil && name == edgeName {
+			return call, nil
+		}
+	}
+	return nil, fmt.Errorf("schemast: could not find edge %q in type %q", edgeName, typeName)
+}
+
+// edgeCalls returns the call expressions returned by the Edges method of type typeName, which may return nil.
+func (c *Context) edgeCalls(typeName string) ([]*ast.CallExpr, error) {
+	return c.returnedCalls(typeName, "Edges")
+}
+
+// returnedCalls returns the call expressions returned by the method of type typeName, which may return nil.
+func (c *Context) returnedCalls(typeName, method string) ([]*ast.CallExpr, error) {
+	stmt, err := c.returnStmt(typeName, method)
+	if err != nil {
+		return nil, err
+	}
+	if id, ok := stmt.Results[0].(*ast.Ident); ok && id.Name == "nil" {
+		return nil, nil
+	}
+	returned, ok := stmt.Results[0].(*ast.CompositeLit)
+	if !ok {
+		return nil, fmt.Errorf("schemast: unexpected AST component type %T", stmt.Results[0])
+	}
+	var calls []*ast.CallExpr
+	for _, item := range returned.Elts {
+		if call, ok := item.(*ast.CallExpr); ok {
+			calls = append(calls, call)
+		}
+	}
+	return calls, nil
+}
+
+// refCall returns the Ref call of the builder chain of an inverse edge that references the edge edgeName.
+func refCall(call *ast.CallExpr, edgeName string) (*ast.CallExpr, bool) {
+	for _, b := range builderCalls(call) {
+		if methodName(b) != "Ref" || len(b.Args) != 1 {
+			continue
+		}
+		if ref, ok := b.Args[0].(*ast.BasicLit); ok && ref.Kind == token.STRING && ref.Value == strconv.Quote(edgeName) {
+			return b, true
+		}
+	}
+	return nil, false
+}
+
+// edgeTarget returns the name of the type that is referenced by an edge constructor, e.g. "User" for
+// edge.To("owner", User.Type).
+func edgeTarget(constructor *ast.CallExpr) (string, bool) {
+	if len(constructor.Args) != 2 {
+		return "", false
+	}
+	sel, ok := constructor.Args[1].(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Type" {
+		return "", false
+	}
+	switch x := sel.X.(type) {
+	case *ast.Ident:
+		return x.Name, true
+	case *ast.SelectorExpr:
+		// A type of another package, e.g. shared.Like.Type.
+		if id, ok := x.X.(*ast.Ident); ok {
+			return id.Name + "." + x.Sel.Name, true
+		}
+	}
+	return "", false
+}
+
 func newEdgeCall(desc *edge.Descriptor) *builderCall {
 	constructor := "To"
 	if desc.Inverse {
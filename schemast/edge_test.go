@@ -16,14 +16,18 @@ package schemast
 
 import (
 	"bytes"
+	"go/ast"
 	"go/printer"
 	"go/token"
 	"testing"
 
 	"entgo.io/contrib/entproto"
-	"entgo.io/contrib/schemast/internal/mutatetest/ent/schema"
+	entschema "entgo.io/contrib/schemast/internal/mutatetest/ent/schema"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 	"github.com/stretchr/testify/require"
 )
 
@@ -59,11 +63,21 @@ func TestFromEdgeDescriptor(t *testing.T) {
 			edge:     edge.To("entity", Entity.Type).Field("field"),
 			expected: `edge.To("entity", Entity.Type).Field("field")`,
 		},
+		{
+			name:     "field_unique_required",
+			edge:     edge.From("owner", Entity.Type).Ref("entities").Unique().Required().Field("owner_id"),
+			expected: `edge.From("owner", Entity.Type).Ref("entities").Required().Unique().Field("owner_id")`,
+		},
 		{
 			name:     "struct_tag",
 			edge:     edge.To("entity", Entity.Type).StructTag("tag"),
 			expected: `edge.To("entity", Entity.Type).StructTag("tag")`,
 		},
+		{
+			name:     "comment",
+			edge:     edge.To("entity", Entity.Type).Comment("The entity of the edge.").StructTag("tag"),
+			expected: `edge.To("entity", Entity.Type).Comment("The entity of the edge.").StructTag("tag")`,
+		},
 		{
 			name:     "storage_key_one_col",
 			edge:     edge.To("entity", Entity.Type).StorageKey(edge.Table("table"), edge.Column("to")),
@@ -74,11 +88,66 @@ func TestFromEdgeDescriptor(t *testing.T) {
 			edge:     edge.To("entity", Entity.Type).StorageKey(edge.Table("table"), edge.Columns("to", "from")),
 			expected: `edge.To("entity", Entity.Type).StorageKey(edge.Table("table"), edge.Columns("to", "from"))`,
 		},
+		{
+			name:     "storage_key_m2m",
+			edge:     edge.To("tags", Entity.Type).StorageKey(edge.Table("post_tags"), edge.Columns("post_id", "tag_id")),
+			expected: `edge.To("tags", Entity.Type).StorageKey(edge.Table("post_tags"), edge.Columns("post_id", "tag_id"))`,
+		},
+		{
+			name:     "storage_key_columns_only",
+			edge:     edge.To("tags", Entity.Type).StorageKey(edge.Columns("post_id", "tag_id")),
+			expected: `edge.To("tags", Entity.Type).StorageKey(edge.Columns("post_id", "tag_id"))`,
+		},
+		{
+			name:     "storage_key_table_only",
+			edge:     edge.To("tags", Entity.Type).StorageKey(edge.Table("post_tags")),
+			expected: `edge.To("tags", Entity.Type).StorageKey(edge.Table("post_tags"))`,
+		},
+		{
+			name:     "through",
+			edge:     edge.To("liked_tweets", Entity.Type).Through("likes", Entity.Type),
+			expected: `edge.To("liked_tweets", Entity.Type).Through("likes", Entity.Type)`,
+		},
+		{
+			name:     "through inverse",
+			edge:     edge.From("liked_users", Entity.Type).Ref("liked_tweets").Through("likes", Entity.Type),
+			expected: `edge.From("liked_users", Entity.Type).Ref("liked_tweets").Through("likes", Entity.Type)`,
+		},
+		{
+			name:     "same type",
+			edge:     edge.To("children", Entity.Type).From("parent").Unique(),
+			expected: `edge.To("children", Entity.Type).From("parent").Unique()`,
+		},
 		{
 			name:     "annotation",
 			edge:     edge.To("entity", Entity.Type).Annotations(entproto.Field(10)),
 			expected: `edge.To("entity", Entity.Type).Annotations(entproto.Field(10))`,
 		},
+		{
+			name:     "annotation on delete",
+			edge:     edge.To("children", Entity.Type).Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+			expected: `edge.To("children", Entity.Type).Annotations(entsql.Annotation{OnDelete: entsql.Cascade})`,
+		},
+		{
+			name:     "annotation on delete inverse",
+			edge:     edge.From("parent", Entity.Type).Ref("children").Unique().Annotations(entsql.Annotation{OnDelete: entsql.SetNull}),
+			expected: `edge.From("parent", Entity.Type).Ref("children").Unique().Annotations(entsql.Annotation{OnDelete: entsql.SetNull})`,
+		},
+		{
+			name:     "annotation same type",
+			edge:     edge.To("children", Entity.Type).Annotations(entproto.Field(2)).From("parent").Unique().Annotations(entproto.Field(3)),
+			expected: `edge.To("children", Entity.Type).Annotations(entproto.Field(2)).From("parent").Unique().Annotations(entproto.Field(3))`,
+		},
+		{
+			name:           "annotation unsupported",
+			edge:           edge.To("children", Entity.Type).Annotations(annotation("unsupported")),
+			expectedErrMsg: `schemast: no Annotator configured for annotation "unsupported"`,
+		},
+		{
+			name:           "annotation unknown on delete",
+			edge:           edge.To("children", Entity.Type).Annotations(entsql.Annotation{OnDelete: "DROP"}),
+			expectedErrMsg: `schemast: unknown entsql ReferenceOption: "DROP"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -128,7 +197,7 @@ func (WithFields) Edges() []ent.Edge {
 		t.Run(tt.typeName, func(t *testing.T) {
 			ctx, err := Load("./internal/mutatetest/ent/schema")
 			require.NoError(t, err)
-			err = ctx.AppendEdge(tt.typeName, edge.To("owner", schema.User.Type).Unique().Descriptor())
+			err = ctx.AppendEdge(tt.typeName, edge.To("owner", entschema.User.Type).Unique().Descriptor())
 			if tt.expectedErr != "" {
 				require.EqualError(t, err, tt.expectedErr)
 				return
@@ -143,6 +212,250 @@ func (WithFields) Edges() []ent.Edge {
 	}
 }
 
+func TestAppendEdgeSelfReference(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddType("Node"))
+	err = tt.ctx.AppendEdge("Node", WithType(edge.To("parent", placeholder.Type).Unique(), "Node").Descriptor())
+	require.NoError(t, err)
+	err = tt.ctx.AppendEdge("Node", WithType(edge.From("children", placeholder.Type).Ref("parent"), "Node").Descriptor())
+	require.NoError(t, err)
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("node.go"), `func (Node) Edges() []ent.Edge {
+	return []ent.Edge{edge.To("parent", Node.Type).Unique(), edge.From("children", Node.Type).Ref("parent")}
+}`)
+	node := tt.getType("Node")
+	require.Len(t, node.Edges, 2)
+	parent, children := node.Edges[0], node.Edges[1]
+	require.EqualValues(t, "Node", parent.Type.Name)
+	require.True(t, parent.Unique)
+	require.EqualValues(t, "Node", children.Type.Name)
+	require.EqualValues(t, "parent", children.Inverse)
+}
+
+func TestAppendEdgeOnDelete(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddType("Node"))
+	children := WithType(edge.To("children", placeholder.Type).Annotations(entsql.Annotation{OnDelete: entsql.Cascade}), "Node")
+	require.NoError(t, tt.ctx.AppendEdge("Node", children.Descriptor()))
+	require.NoError(t, tt.ctx.AppendEdge("Node", WithType(edge.From("parent", placeholder.Type).Ref("children").Unique(), "Node").Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	node := tt.getType("Node")
+	require.Len(t, node.Edges, 2)
+	annot, ok := node.Edges[0].Annotations["EntSQL"].(map[string]interface{})
+	require.True(t, ok)
+	require.EqualValues(t, entsql.Cascade, annot["on_delete"])
+}
+
+func TestAppendEdgeAnnotations(t *testing.T) {
+	require.NoError(t, RegisterAnnotation("Audit", func(schema.Annotation) (ast.Expr, error) {
+		return fnCall(selectorLit("audit", "Enabled")), nil
+	}))
+	UseReflectedAnnotations(true)
+	t.Cleanup(func() {
+		UseReflectedAnnotations(false)
+		annotators.Lock()
+		delete(annotators.m, "Audit")
+		annotators.Unlock()
+	})
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	desc := edge.To("owner", entschema.User.Type).Annotations(audit{}, tagsAnnotation{Tags: []string{"x"}}).Descriptor()
+	expr, err := ctx.AppendEdgeExpr("WithFields", desc)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), expr))
+	require.EqualValues(t, `edge.To("owner", User.Type).Annotations(audit.Enabled(), schemast.tagsAnnotation{Tags: []string{"x"}})`, buf.String())
+	var paths []string
+	for _, imp := range ctx.methodFile("WithFields", "Edges").Imports {
+		paths = append(paths, imp.Path.Value)
+	}
+	require.Contains(t, paths, `"entgo.io/contrib/schemast"`)
+}
+
+func TestAppendEdgeStorageKey(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	friends := WithType(edge.To("friends", placeholder.Type).StorageKey(edge.Table("friendships"), edge.Columns("user_id", "friend_id")), "User")
+	require.NoError(t, tt.ctx.AppendEdge("User", friends.Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `edge.To("friends", User.Type).StorageKey(edge.Table("friendships"), edge.Columns("user_id", "friend_id"))`)
+	rel := tt.getType("User").Edges[0].Rel
+	require.EqualValues(t, "friendships", rel.Table)
+	require.EqualValues(t, []string{"user_id", "friend_id"}, rel.Columns)
+}
+
+func TestAppendEdgeStructTag(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	children := WithType(edge.To("children", placeholder.Type).StructTag(`json:"children,omitempty"`).From("parent").Unique().StructTag(`json:"parent,omitempty"`), "User")
+	children.Descriptor().Ref.Type = "User"
+	require.NoError(t, tt.ctx.AppendEdge("User", children.Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `StructTag("json:\"children,omitempty\"").From("parent").Unique().StructTag("json:\"parent,omitempty\"")`)
+	tags := make(map[string]string)
+	for _, e := range tt.getType("User").Edges {
+		tags[e.Name] = e.StructTag
+	}
+	require.EqualValues(t, map[string]string{
+		"children": `json:"children,omitempty"`,
+		"parent":   `json:"parent,omitempty"`,
+	}, tags)
+}
+
+func TestAppendEdgeComment(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	owner := WithType(edge.From("owner", placeholder.Type).Ref("messages").Unique().Comment("The user that sent the message.\nSet on creation."), "User")
+	require.NoError(t, tt.ctx.AppendEdge("Message", owner.Descriptor()))
+	messages := WithType(edge.To("messages", placeholder.Type).Comment("Messages sent by the user."), "Message")
+	require.NoError(t, tt.ctx.AppendEdge("User", messages.Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("message.go"), `edge.From("owner", User.Type).Ref("messages").Unique().Comment("The user that sent the message.\nSet on creation.")`)
+	require.EqualValues(t, "The user that sent the message.\nSet on creation.", tt.getType("Message").Edges[0].Comment())
+	require.EqualValues(t, "Messages sent by the user.", tt.getType("User").Edges[0].Comment())
+}
+
+func TestAppendEdgeField(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendField("Message", field.Int("owner_id").Descriptor()))
+	owner := WithType(edge.From("owner", placeholder.Type).Ref("messages").Unique().Required().Field("owner_id"), "User")
+	require.NoError(t, tt.ctx.AppendEdge("Message", owner.Descriptor()))
+	messages := WithType(edge.To("messages", placeholder.Type), "Message")
+	require.NoError(t, tt.ctx.AppendEdge("User", messages.Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("message.go"), `edge.From("owner", User.Type).Ref("messages").Required().Unique().Field("owner_id")`)
+	message := tt.getType("Message")
+	require.Len(t, message.Edges, 1)
+	require.NotNil(t, message.Edges[0].Field())
+	require.EqualValues(t, "owner_id", message.Edges[0].Field().Name)
+	require.True(t, message.Edges[0].Unique)
+	require.False(t, message.Edges[0].Optional)
+}
+
+func TestRenameEdge(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	messages := WithType(edge.To("messages", placeholder.Type), "Message")
+	require.NoError(t, tt.ctx.AppendEdge("User", messages.Descriptor()))
+	owner := WithType(edge.From("owner", placeholder.Type).Ref("messages").Unique(), "User")
+	require.NoError(t, tt.ctx.AppendEdge("Message", owner.Descriptor()))
+
+	err = tt.ctx.RenameEdge("User", "non_existent", "other")
+	require.EqualError(t, err, `schemast: could not find edge "non_existent" in type "User"`)
+	err = tt.ctx.RenameEdge("User", "messages", "messages")
+	require.EqualError(t, err, `schemast: edge "messages" already exists in type "User"`)
+	require.NoError(t, tt.ctx.RenameEdge("User", "messages", "sent_messages"))
+	require.NoError(t, tt.ctx.RenameEdge("Message", "owner", "sender"))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `edge.To("sent_messages", Message.Type)`)
+	require.Contains(t, tt.contents("message.go"), `edge.From("sender", User.Type).Ref("sent_messages").Unique()`)
+	sender := tt.getType("Message").Edges[0]
+	require.EqualValues(t, "sender", sender.Name)
+	require.EqualValues(t, "sent_messages", sender.Inverse)
+}
+
+func TestUpdateEdge(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	for _, e := range []ent.Edge{
+		WithType(edge.To("messages", placeholder.Type), "Message"),
+		WithType(edge.To("friends", placeholder.Type), "User"),
+	} {
+		require.NoError(t, tt.ctx.AppendEdge("User", e.Descriptor()))
+	}
+	messages := WithType(edge.To("messages", placeholder.Type).StorageKey(edge.Column("author_id")).Comment("Messages sent by the user."), "Message")
+	err = tt.ctx.UpdateEdge("User", "non_existent", messages.Descriptor())
+	require.EqualError(t, err, `schemast: could not find edge "non_existent" in type "User"`)
+	err = tt.ctx.UpdateEdge("User", "friends", messages.Descriptor())
+	require.EqualError(t, err, `schemast: edge "messages" already exists in type "User"`)
+	require.NoError(t, tt.ctx.UpdateEdge("User", "messages", messages.Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `edge.To("messages", Message.Type).StorageKey(edge.Column("author_id")).Comment("Messages sent by the user.")`)
+	edges := tt.getType("User").Edges
+	require.Len(t, edges, 2)
+	require.EqualValues(t, "messages", edges[0].Name)
+	require.EqualValues(t, []string{"author_id"}, edges[0].Rel.Columns)
+	require.EqualValues(t, "friends", edges[1].Name)
+}
+
+func TestUpdateEdgeImports(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	tt.ctx, err = Load("./internal/printtest/ent/schema", WithPackages("./internal/sharedtest"))
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendEdge("Message", WithType(edge.To("likes", placeholder.Type), "User").Descriptor()))
+	require.NoError(t, tt.ctx.UpdateEdge("Message", "likes", WithType(edge.To("likes", placeholder.Type), "sharedtest.Like").Descriptor()))
+	var paths []string
+	for _, imp := range tt.ctx.methodFile("Message", "Edges").Imports {
+		paths = append(paths, imp.Path.Value)
+	}
+	require.Contains(t, paths, `"entgo.io/contrib/schemast/internal/sharedtest"`)
+	require.NoError(t, tt.print())
+
+	require.Contains(t, tt.contents("message.go"), `edge.To("likes", sharedtest.Like.Type)`)
+}
+
+func TestAppendEdgeThrough(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	for _, name := range []string{"Tweet", "Like"} {
+		require.NoError(t, tt.ctx.AddType(name))
+	}
+	require.NoError(t, tt.ctx.AppendField("Like", field.Int("user_id").Descriptor()))
+	require.NoError(t, tt.ctx.AppendField("Like", field.Int("tweet_id").Descriptor()))
+	for _, e := range []ent.Edge{
+		WithType(edge.To("user", placeholder.Type).Unique().Required().Field("user_id"), "User"),
+		WithType(edge.To("tweet", placeholder.Type).Unique().Required().Field("tweet_id"), "Tweet"),
+	} {
+		require.NoError(t, tt.ctx.AppendEdge("Like", e.Descriptor()))
+	}
+	liked := WithThroughType(WithType(edge.To("liked_tweets", placeholder.Type).Through("likes", placeholder.Type), "Tweet"), "Like")
+	require.NoError(t, tt.ctx.AppendEdge("User", liked.Descriptor()))
+	users := WithThroughType(WithType(edge.From("liked_users", placeholder.Type).Ref("liked_tweets").Through("likes", placeholder.Type), "User"), "Like")
+	require.NoError(t, tt.ctx.AppendEdge("Tweet", users.Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `edge.To("liked_tweets", Tweet.Type).Through("likes", Like.Type)`)
+	user := tt.getType("User")
+	require.Len(t, user.Edges, 2)
+	require.EqualValues(t, "liked_tweets", user.Edges[0].Name)
+	require.EqualValues(t, "Like", user.Edges[0].Through.Name)
+	require.EqualValues(t, "likes", user.Edges[1].Name)
+	require.True(t, tt.getType("Like").IsEdgeSchema())
+}
+
+func TestAppendEdgeExpr(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	expr, err := ctx.AppendEdgeExpr("WithFields", edge.To("owner", entschema.User.Type).Unique().Descriptor())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), expr))
+	require.EqualValues(t, `edge.To("owner", User.Type).Unique()`, buf.String())
+	_, err = ctx.AppendEdgeExpr("Nothing", edge.To("owner", entschema.User.Type).Descriptor())
+	require.EqualError(t, err, `schemast: type "Nothing" not found`)
+}
+
 func TestRemoveEdge(t *testing.T) {
 	ctx, err := Load("./internal/mutatetest/ent/schema")
 	require.NoError(t, err)
@@ -159,3 +472,87 @@ func TestRemoveEdge(t *testing.T) {
 	return []ent.Edge{}
 }`, buf.String())
 }
+
+func TestCheckEdges(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddType("Pet"))
+	require.NoError(t, tt.ctx.AppendField("Pet", field.Int("owner_id").Optional().Descriptor()))
+	require.NoError(t, tt.ctx.AppendField("Message", field.Int("owner_id").Descriptor()))
+	children := WithType(edge.To("children", placeholder.Type).From("parent").Unique(), "User")
+	children.Descriptor().Ref.Type = "User"
+	for _, e := range []ent.Edge{
+		WithType(edge.To("pets", placeholder.Type), "Pet"),
+		WithType(edge.To("groups", placeholder.Type), "Group"),
+		children,
+	} {
+		require.NoError(t, tt.ctx.AppendEdge("User", e.Descriptor()))
+	}
+	for _, e := range []ent.Edge{
+		WithType(edge.From("owner", placeholder.Type).Ref("pets").Unique().Required().Field("owner_id"), "User"),
+		WithType(edge.From("friend", placeholder.Type).Ref("friends").Unique(), "User"),
+	} {
+		require.NoError(t, tt.ctx.AppendEdge("Pet", e.Descriptor()))
+	}
+	for _, e := range []ent.Edge{
+		WithType(edge.From("owner", placeholder.Type).Ref("pets").Field("owner_id"), "User"),
+		WithType(edge.From("author", placeholder.Type).Ref("author").Unique().Field("author_id"), "User"),
+	} {
+		require.NoError(t, tt.ctx.AppendEdge("Message", e.Descriptor()))
+	}
+	require.NoError(t, tt.print())
+	ctx, err := Load(tt.schemaDir())
+	require.NoError(t, err)
+
+	diags, err := ctx.CheckEdges()
+	require.NoError(t, err)
+	var msgs []string
+	for _, d := range diags {
+		require.True(t, d.Pos.IsValid())
+		msgs = append(msgs, d.Type+"."+d.Edge+": "+d.Message)
+	}
+	require.EqualValues(t, []string{
+		`Message.owner: reference "pets" does not match an edge.To of type "User" to type "Message"`,
+		`Message.owner: edge bound to field "owner_id" must be unique`,
+		`Message.owner: edge is required=false but bound field "owner_id" is optional=false`,
+		`Message.author: reference "author" does not match an edge.To of type "User" to type "Message"`,
+		`Message.author: bound field "author_id" is not declared`,
+		`Pet.owner: edge is required=true but bound field "owner_id" is optional=true`,
+		`Pet.friend: reference "friends" does not match an edge.To of type "User" to type "Pet"`,
+		`User.groups: type "Group" is not declared`,
+	}, msgs)
+	require.Regexp(t, `^.*pet\.go:\d+:\d+: Pet\.owner: edge is required=true`, diags[5].String())
+}
+
+func TestUpsertEdgeSchemaCompositeID(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	teams := WithThroughType(WithType(edge.To("teams", placeholder.Type).Through("memberships", placeholder.Type), "Team"), "Membership")
+	members := WithThroughType(WithType(edge.From("members", placeholder.Type).Ref("teams").Through("memberships", placeholder.Type), "User"), "Membership")
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{
+		Name: "Membership",
+		Fields: []ent.Field{
+			field.Int("user_id"),
+			field.Int("team_id"),
+		},
+		Edges: []ent.Edge{
+			WithType(edge.To("user", placeholder.Type).Unique().Required().Field("user_id"), "User"),
+			WithType(edge.To("team", placeholder.Type).Unique().Required().Field("team_id"), "Team"),
+		},
+		Annotations: []schema.Annotation{
+			field.ID("user_id", "team_id"),
+		},
+	}, &UpsertSchema{
+		Name:  "Team",
+		Edges: []ent.Edge{members},
+	}))
+	require.NoError(t, tt.ctx.AppendEdge("User", teams.Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("membership.go"), `field.ID("user_id", "team_id")`)
+	membership := tt.getType("Membership")
+	require.True(t, membership.IsEdgeSchema())
+	require.True(t, membership.HasCompositeID())
+	require.EqualValues(t, "Membership", tt.getType("Team").Edges[0].Through.Name)
+}
@@ -15,16 +15,17 @@
 package schemast
 
 import (
-	"errors"
+	"bytes"
 	"fmt"
 	"go/ast"
-	"go/parser"
+	"go/printer"
 	"go/token"
+	"math"
 	"reflect"
-	"runtime"
 	"strconv"
 	"strings"
 
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
 )
 
@@ -34,46 +35,372 @@ func Field(desc *field.Descriptor) (*ast.CallExpr, error) {
 	switch t := desc.Info.Type; {
 	case t.Numeric(), t == field.TypeString, t == field.TypeBool, t == field.TypeTime, t == field.TypeBytes:
 		return fromSimpleType(desc)
-	case t == field.TypeUUID:
-		return fromComplexType(
-			desc,
-			structLit(
-				&ast.SelectorExpr{
-					X:   ast.NewIdent("uuid"),
-					Sel: ast.NewIdent("UUID"),
-				},
-			))
-	case t == field.TypeJSON:
-		exp, err := parser.ParseExpr("struct{}{}")
+	case t == field.TypeJSON && jsonHelper(desc) != "":
+		return fromSimpleType(desc)
+	case t == field.TypeUUID, t == field.TypeJSON, t == field.TypeOther:
+		expr, _, err := goTypeExpr(desc.Info)
 		if err != nil {
-			return nil, fmt.Errorf("schemast: json field %s generation error %w", desc.Name, err)
-		}
-		if c, ok := exp.(*ast.CompositeLit); ok {
-			if v, ok := c.Type.(*ast.StructType); ok {
-				v.Fields = &ast.FieldList{
-					Opening: 1,
-					Closing: 1,
-				}
-			}
+			return nil, fmt.Errorf("%w of field %q", err, desc.Name)
 		}
-		return fromComplexType(
-			desc,
-			exp,
-		)
+		return fromComplexType(desc, expr)
 	case t == field.TypeEnum:
-		return fromEnumType(desc)
+		return fromSimpleType(desc)
 	default:
 		return nil, fmt.Errorf("schemast: unsupported type %s", t.ConstName())
 	}
 }
 
 // AppendField adds a field to the returned values of the Fields method of type typeName.
-func (c *Context) AppendField(typeName string, desc *field.Descriptor) error {
-	newField, err := Field(desc)
+// AppendField receives functional options of type AppendOption that modify its behavior.
+func (c *Context) AppendField(typeName string, desc *field.Descriptor, opts ...AppendOption) error {
+	_, err := c.AppendFieldExpr(typeName, desc, opts...)
+	return err
+}
+
+// AppendFieldExpr is like AppendField, but also returns the expression that was added to the Fields method.
+func (c *Context) AppendFieldExpr(typeName string, desc *field.Descriptor, opts ...AppendOption) (ast.Expr, error) {
+	options := &appendOpts{}
+	for _, apply := range opts {
+		apply(options)
+	}
+	if err := checkFieldName(desc.Name); err != nil {
+		return nil, err
+	}
+	newField, desc, err := c.fieldExpr(typeName, desc)
+	if err != nil {
+		return nil, err
+	}
+	var added ast.Expr = newField
+	switch {
+	case options.first:
+		if added, err = c.prependItem(kindField, typeName, newField); err != nil {
+			return nil, err
+		}
+	case options.insert:
+		if added, err = c.insertItem(kindField, typeName, options.at, newField); err != nil {
+			return nil, err
+		}
+	case options.group != "":
+		if added, err = c.appendToGroup(kindField, typeName, options.group, newField); err != nil {
+			return nil, err
+		}
+	default:
+		if added, err = c.appendReturnItem(kindField, typeName, newField); err != nil {
+			return nil, err
+		}
+	}
+	c.appendImports(c.methodFile(typeName, kindField.methodName), fieldImports(desc))
+	for _, lint := range c.fieldLinters {
+		for _, w := range lint(typeName, desc) {
+			c.warn("%s.%s: %s", typeName, desc.Name, w)
+		}
+	}
+	return added, nil
+}
+
+// fieldExpr returns the expression of desc, added to the type typeName. If the Context is lenient and Field
+// fails, the features of desc that cannot be serialized are dropped and reported as warnings, and the returned
+// descriptor is the one without these features.
+func (c *Context) fieldExpr(typeName string, desc *field.Descriptor) (*ast.CallExpr, *field.Descriptor, error) {
+	expr, err := Field(desc)
+	if err == nil || !c.Lenient {
+		return expr, desc, err
+	}
+	stripped, dropped := lenientDescriptor(desc)
+	if len(dropped) == 0 {
+		return nil, nil, err
+	}
+	if expr, err = Field(stripped); err != nil {
+		return nil, nil, err
+	}
+	for _, feature := range dropped {
+		c.warn("%s.%s: dropped unsupported feature %s", typeName, desc.Name, feature)
+	}
+	return expr, stripped, nil
+}
+
+// lenientDescriptor returns a copy of desc without the features that Field cannot serialize, along with their
+// names. Unlike stripUnsupported, only the annotations without an Annotator are dropped.
+func lenientDescriptor(desc *field.Descriptor) (*field.Descriptor, []string) {
+	stripped := *desc
+	stripped.Annotations = nil
+	var dropped []string
+	for _, annot := range desc.Annotations {
+		if _, _, err := Annotation(annot); err != nil {
+			dropped = append(dropped, fmt.Sprintf("Descriptor.Annotations (%s)", annot.Name()))
+			continue
+		}
+		stripped.Annotations = append(stripped.Annotations, annot)
+	}
+	for _, g := range fieldGrafts {
+		if g.unsupported(&stripped) {
+			g.strip(&stripped)
+			dropped = append(dropped, g.feature)
+		}
+	}
+	return &stripped, dropped
+}
+
+// InsertField adds a field at index among the returned values of the Fields method of type typeName, where
+// index is in the range [0, n] for a type with n fields. The field is placed right after the field that
+// precedes it. As the order of the fields is the order of the columns in the database, InsertField allows
+// adding columns next to related ones.
+func (c *Context) InsertField(typeName string, desc *field.Descriptor, index int) error {
+	_, err := c.AppendFieldExpr(typeName, desc, atIndex(index))
+	return err
+}
+
+// MoveField moves the field named fieldName of type typeName, along with the comments that directly precede
+// it, to index among the returned values of the Fields method. The other fields and comments keep their order.
+func (c *Context) MoveField(typeName, fieldName string, index int) error {
+	stmt, err := c.returnStmt(typeName, kindField.methodName)
+	if err != nil {
+		return err
+	}
+	if returned, ok := stmt.Results[0].(*ast.CompositeLit); ok {
+		for i, item := range returned.Elts {
+			call, ok := item.(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			if name, err := extractFieldName(call); err == nil && name == fieldName {
+				return c.moveItem(kindField, typeName, i, index)
+			}
+		}
+	}
+	return fmt.Errorf("schemast: could not find field %q in type %q", fieldName, typeName)
+}
+
+// UpdateField replaces the declaration of the field named desc.Name in the Fields method of type typeName with
+// the one of desc. Unlike RemoveField followed by AppendField, the field keeps its position, and the other
+// fields and the comments of the Fields method are left untouched.
+func (c *Context) UpdateField(typeName string, desc *field.Descriptor) error {
+	if _, err := c.lookupField(typeName, desc.Name); err != nil {
+		return err
+	}
+	newField, desc, err := c.fieldExpr(typeName, desc)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, c.SchemaPackage.Fset, newField); err != nil {
+		return err
+	}
+	file, src, err := c.reparse(c.methodFile(typeName, kindField.methodName))
+	if err != nil {
+		return err
+	}
+	call, err := c.lookupField(typeName, desc.Name)
+	if err != nil {
+		return err
+	}
+	start, end := c.offset(call.Pos()), c.offset(call.End())
+	updated := append(append(append([]byte{}, src[:start]...), buf.Bytes()...), src[end:]...)
+	if _, _, err := c.replaceSource(file, updated); err != nil {
+		return err
+	}
+	c.appendImports(c.methodFile(typeName, kindField.methodName), fieldImports(desc))
+	for _, lint := range c.fieldLinters {
+		for _, w := range lint(typeName, desc) {
+			c.warn("%s.%s: %s", typeName, desc.Name, w)
+		}
+	}
+	return nil
+}
+
+// DeprecateField adds a "// Deprecated: note" comment above the field named fieldName in the Fields method of
+// type typeName. If annots are provided, they are added to the field using the Annotations method. The rest of
+// the builder chain of the field is left unchanged.
+//
+// The field descriptor of the ent version used by schemast does not carry a deprecation reason, and its
+// builders have no Deprecated method, so the comment is the only way a field is marked as deprecated.
+func (c *Context) DeprecateField(typeName, fieldName, note string, annots ...schema.Annotation) error {
+	l, err := c.returnedLiteral(kindField, typeName)
 	if err != nil {
 		return err
 	}
-	return c.appendReturnItem(kindField, typeName, newField)
+	var entries []literalEntry
+	found := false
+	for _, e := range l.entries {
+		if call, ok := e.node.(*ast.CallExpr); ok {
+			if name, err := extractFieldName(call); err == nil && name == fieldName {
+				found = true
+				entries = append(entries, commentEntries("Deprecated: "+note)...)
+				if len(annots) > 0 {
+					exprs, err := toAnnotASTs(annots)
+					if err != nil {
+						return err
+					}
+					builder := &builderCall{curr: call}
+					builder.annotate(exprs...)
+					comment := e.comment
+					if e, err = c.exprEntry(builder.curr); err != nil {
+						return err
+					}
+					e.comment = comment
+				}
+			}
+		}
+		entries = append(entries, e)
+	}
+	if !found {
+		return fmt.Errorf("schemast: could not find field %q in type %q", fieldName, typeName)
+	}
+	return c.rewrite(l, entries)
+}
+
+// fieldConstructorTypes maps the constructors of the ent field package to the type of the fields they create.
+var fieldConstructorTypes = map[string]field.Type{
+	"Bool":    field.TypeBool,
+	"Time":    field.TypeTime,
+	"JSON":    field.TypeJSON,
+	"Strings": field.TypeJSON,
+	"Ints":    field.TypeJSON,
+	"Floats":  field.TypeJSON,
+	"UUID":    field.TypeUUID,
+	"Bytes":   field.TypeBytes,
+	"Enum":    field.TypeEnum,
+	"String":  field.TypeString,
+	"Text":    field.TypeString,
+	"Other":   field.TypeOther,
+	"Int":     field.TypeInt,
+	"Int8":    field.TypeInt8,
+	"Int16":   field.TypeInt16,
+	"Int32":   field.TypeInt32,
+	"Int64":   field.TypeInt64,
+	"Uint":    field.TypeUint,
+	"Uint8":   field.TypeUint8,
+	"Uint16":  field.TypeUint16,
+	"Uint32":  field.TypeUint32,
+	"Uint64":  field.TypeUint64,
+	"Float":   field.TypeFloat64,
+	"Float32": field.TypeFloat32,
+}
+
+// FieldType returns the field.Type of the field named fieldName of type typeName, based on the constructor
+// of the ent field package that is used to declare it (e.g. field.String).
+func (c *Context) FieldType(typeName, fieldName string) (field.Type, error) {
+	call, err := c.lookupField(typeName, fieldName)
+	if err != nil {
+		return field.TypeInvalid, err
+	}
+	constructor := constructorName(call)
+	t, ok := fieldConstructorTypes[constructor]
+	if !ok {
+		return field.TypeInvalid, fmt.Errorf("schemast: unrecognized field constructor %q for field %q", constructor, fieldName)
+	}
+	return t, nil
+}
+
+// lookupField returns the expression declaring the field named fieldName in the Fields method of type typeName.
+func (c *Context) lookupField(typeName, fieldName string) (*ast.CallExpr, error) {
+	stmt, err := c.returnStmt(typeName, "Fields")
+	if err != nil {
+		return nil, err
+	}
+	if returned, ok := stmt.Results[0].(*ast.CompositeLit); ok {
+		for _, item := range returned.Elts {
+			call, ok := item.(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			if name, err := extractFieldName(call); err == nil && name == fieldName {
+				return call, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("schemast: could not find field %q in type %q", fieldName, typeName)
+}
+
+// isFirstField reports whether call is the first value returned by the Fields method of type typeName.
+func (c *Context) isFirstField(typeName string, call *ast.CallExpr) bool {
+	stmt, err := c.returnStmt(typeName, kindField.methodName)
+	if err != nil {
+		return false
+	}
+	returned, ok := stmt.Results[0].(*ast.CompositeLit)
+	return ok && len(returned.Elts) > 0 && returned.Elts[0] == call
+}
+
+// constructorName returns the name of the constructor at the start of a builder chain, e.g. "String" for
+// field.String("name").Optional().
+func constructorName(call *ast.CallExpr) string {
+	return methodName(constructorCall(call))
+}
+
+// constructorCall returns the call at the start of a builder chain, e.g. field.String("name") for
+// field.String("name").Optional().
+func constructorCall(call *ast.CallExpr) *ast.CallExpr {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if inner, ok := sel.X.(*ast.CallExpr); ok {
+			return constructorCall(inner)
+		}
+	}
+	return call
+}
+
+// builderCalls returns the calls of the builder chain of call, starting with its constructor, e.g.
+// field.String("name") and Optional() for field.String("name").Optional().
+func builderCalls(call *ast.CallExpr) []*ast.CallExpr {
+	calls := []*ast.CallExpr{call}
+	for {
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+		inner, ok := sel.X.(*ast.CallExpr)
+		if !ok {
+			break
+		}
+		calls = append([]*ast.CallExpr{inner}, calls...)
+		call = inner
+	}
+	return calls
+}
+
+// methodName returns the name of the function or method called by call, e.g. "Optional" for
+// field.String("name").Optional().
+func methodName(call *ast.CallExpr) string {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		return sel.Sel.Name
+	}
+	return ""
+}
+
+// fieldNames returns the names of the fields returned by the Fields method of type typeName, in order.
+func (c *Context) fieldNames(typeName string) []string {
+	if _, ok := c.lookupMethod(typeName, kindField.methodName); !ok {
+		return nil
+	}
+	calls, err := c.returnedCalls(typeName, kindField.methodName)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, call := range calls {
+		if name, err := extractFieldName(call); err == nil {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// HasField reports whether the Fields method of type typeName, or of one of its mixins, returns a field named
+// fieldName. The mixins declared in other packages are resolved as well, see WithPackages. The packages imported
+// by the schema package are loaded to resolve their mixins, and the ones that fail to load are reported as
+// warnings (see Warnings).
+func (c *Context) HasField(typeName string, fieldName string) bool {
+	_, ok := c.effectiveField(typeName, fieldName)
+	return ok
+}
+
+// effectiveField returns the declaration of the field named fieldName of type typeName, either in its Fields
+// method or in the Fields method of one of its mixins.
+func (c *Context) effectiveField(typeName, fieldName string) (*ast.CallExpr, bool) {
+	if call, err := c.lookupField(typeName, fieldName); err == nil {
+		return call, true
+	}
+	return c.mixinField(typeName, fieldName)
 }
 
 // RemoveField removes a field from the returned values of the Fields method of type typeName.
@@ -103,6 +430,176 @@ func (c *Context) RemoveField(typeName string, fieldName string) error {
 	return fmt.Errorf("schemast: could not find field %q in type %q", fieldName, typeName)
 }
 
+// RenameField renames the field oldName of type typeName to newName. The references to the field in the
+// Indexes method of the type (index.Fields), in the Field bindings of its edges and in its field.ID annotation
+// are renamed as well. If one of these references is not a string literal, an error is returned and the
+// Context is left unchanged.
+func (c *Context) RenameField(typeName, oldName, newName string) error {
+	call, err := c.lookupField(typeName, oldName)
+	if err != nil {
+		return err
+	}
+	if err := checkFieldName(newName); err != nil {
+		return err
+	}
+	if c.HasField(typeName, newName) {
+		return fmt.Errorf("schemast: field %q already exists in type %q", newName, typeName)
+	}
+	refs := []*ast.BasicLit{constructorCall(call).Args[0].(*ast.BasicLit)}
+	collect := func(method string, match func(*ast.CallExpr) bool) error {
+		if _, ok := c.lookupMethod(typeName, method); !ok {
+			return nil
+		}
+		calls, err := c.returnedCalls(typeName, method)
+		if err != nil {
+			return err
+		}
+		for _, call := range calls {
+			for _, b := range builderCalls(call) {
+				if !match(b) {
+					continue
+				}
+				for _, arg := range b.Args {
+					lit, ok := arg.(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						return fmt.Errorf("schemast: cannot rename field %q of type %q, the %s method references fields with a non-literal expression", oldName, typeName, method)
+					}
+					if v, err := strconv.Unquote(lit.Value); err == nil && v == oldName {
+						refs = append(refs, lit)
+					}
+				}
+			}
+		}
+		return nil
+	}
+	if err := collect("Indexes", func(b *ast.CallExpr) bool {
+		return methodName(b) == "Fields"
+	}); err != nil {
+		return err
+	}
+	if err := collect("Edges", func(b *ast.CallExpr) bool {
+		return methodName(b) == "Field"
+	}); err != nil {
+		return err
+	}
+	if err := collect("Annotations", func(b *ast.CallExpr) bool {
+		sel, ok := b.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "ID" {
+			return false
+		}
+		x, ok := sel.X.(*ast.Ident)
+		return ok && x.Name == "field"
+	}); err != nil {
+		return err
+	}
+	for _, lit := range refs {
+		lit.Value = strconv.Quote(newName)
+	}
+	return nil
+}
+
+// AppendEnumValue adds value to the values of the enum field named fieldName of type typeName. The last
+// Values or NamedValues call of the field is edited in place, leaving its other modifiers untouched.
+// For fields declared with NamedValues, value is used as both the name and the value of the new pair.
+func (c *Context) AppendEnumValue(typeName, fieldName, value string) error {
+	calls, err := c.enumValueCalls(typeName, fieldName)
+	if err != nil {
+		return err
+	}
+	for _, call := range calls {
+		if _, ok := enumValueIndex(call, value); ok {
+			return fmt.Errorf("schemast: enum field %q of type %q already has value %q", fieldName, typeName, value)
+		}
+	}
+	call := calls[len(calls)-1]
+	if methodName(call) == "NamedValues" {
+		call.Args = append(call.Args, strLit(value))
+	}
+	call.Args = append(call.Args, strLit(value))
+	return nil
+}
+
+// RemoveEnumValue removes value from the Values or NamedValues calls of the enum field named fieldName of
+// type typeName, leaving its other modifiers untouched.
+func (c *Context) RemoveEnumValue(typeName, fieldName, value string) error {
+	calls, err := c.enumValueCalls(typeName, fieldName)
+	if err != nil {
+		return err
+	}
+	for _, call := range calls {
+		i, ok := enumValueIndex(call, value)
+		if !ok {
+			continue
+		}
+		from := i
+		if methodName(call) == "NamedValues" {
+			from = i - 1
+		}
+		call.Args = append(call.Args[:from], call.Args[i+1:]...)
+		return nil
+	}
+	return fmt.Errorf("schemast: enum field %q of type %q has no value %q", fieldName, typeName, value)
+}
+
+// enumValueCalls returns the Values and NamedValues calls of the enum field named fieldName of type
+// typeName, in the order they are applied.
+func (c *Context) enumValueCalls(typeName, fieldName string) ([]*ast.CallExpr, error) {
+	call, err := c.lookupField(typeName, fieldName)
+	if err != nil {
+		return nil, err
+	}
+	if constructorName(call) != "Enum" {
+		return nil, fmt.Errorf("schemast: field %q of type %q is not an enum", fieldName, typeName)
+	}
+	var calls []*ast.CallExpr
+	for _, call := range builderCalls(call) {
+		if name := methodName(call); name == "Values" || name == "NamedValues" {
+			calls = append(calls, call)
+		}
+	}
+	if len(calls) == 0 {
+		return nil, fmt.Errorf("schemast: could not find the values of enum field %q in type %q", fieldName, typeName)
+	}
+	return calls, nil
+}
+
+// enumValueIndex returns the index of the argument of a Values or NamedValues call that holds value.
+func enumValueIndex(call *ast.CallExpr, value string) (int, bool) {
+	start, step := 0, 1
+	if methodName(call) == "NamedValues" {
+		start, step = 1, 2
+	}
+	for i := start; i < len(call.Args); i += step {
+		lit, ok := call.Args[i].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+		if v, err := strconv.Unquote(lit.Value); err == nil && v == value {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// reservedFieldNames holds identifiers that are used by the code generated by ent and
+// therefore cannot be used as field names.
+var reservedFieldNames = map[string]struct{}{
+	"config": {},
+	"edges":  {},
+}
+
+// checkFieldName reports an error if name cannot be used as a field name because it
+// conflicts with a Go keyword or an identifier reserved by ent.
+func checkFieldName(name string) error {
+	if token.Lookup(name).IsKeyword() {
+		return fmt.Errorf("schemast: field name %q conflicts with Go keyword", name)
+	}
+	if _, ok := reservedFieldNames[name]; ok {
+		return fmt.Errorf("schemast: field name %q conflicts with ent reserved identifier", name)
+	}
+	return nil
+}
+
 func newFieldCall(desc *field.Descriptor) *builderCall {
 	return &builderCall{
 		curr: &ast.CallExpr{
@@ -117,11 +614,8 @@ func newFieldCall(desc *field.Descriptor) *builderCall {
 	}
 }
 
-func fromEnumType(desc *field.Descriptor) (*ast.CallExpr, error) {
-	call, err := fromSimpleType(desc)
-	if err != nil {
-		return nil, err
-	}
+// enumValues returns the Values or NamedValues method that declares the values of the enum field desc.
+func enumValues(desc *field.Descriptor) (string, []ast.Expr) {
 	modifier := "Values"
 	for _, pair := range desc.Enums {
 		if pair.N != pair.V {
@@ -136,9 +630,7 @@ func fromEnumType(desc *field.Descriptor) (*ast.CallExpr, error) {
 			args = append(args, strLit(pair.V))
 		}
 	}
-	builder := &builderCall{curr: call}
-	builder.method(modifier, args...)
-	return builder.curr, nil
+	return modifier, args
 }
 
 func fromComplexType(desc *field.Descriptor, filedType ast.Expr) (*ast.CallExpr, error) {
@@ -146,13 +638,18 @@ func fromComplexType(desc *field.Descriptor, filedType ast.Expr) (*ast.CallExpr,
 	if err != nil {
 		return nil, err
 	}
-
-	call.Args = append(call.Args, filedType)
+	constructor := constructorCall(call)
+	constructor.Args = append(constructor.Args, filedType)
 	return call, nil
 }
 
 func fromSimpleType(desc *field.Descriptor) (*ast.CallExpr, error) {
 	builder := newFieldCall(desc)
+	// The values of enums backed by a Go type are provided by its Values method.
+	if desc.Info.Type == field.TypeEnum && !hasGoType(desc) {
+		modifier, values := enumValues(desc)
+		builder.method(modifier, values...)
+	}
 	if desc.Nillable {
 		builder.method("Nillable")
 	}
@@ -165,6 +662,24 @@ func fromSimpleType(desc *field.Descriptor) (*ast.CallExpr, error) {
 	if desc.Sensitive {
 		builder.method("Sensitive")
 	}
+	validators, ok := validatorCalls(desc)
+	for _, v := range validators {
+		builder.method(v.method, v.args...)
+	}
+	if desc.Default != nil {
+		expr, err := defaultExpr(desc.Default)
+		if err != nil {
+			return nil, err
+		}
+		builder.method("Default", expr)
+	}
+	if desc.UpdateDefault != nil {
+		expr, err := defaultExpr(desc.UpdateDefault)
+		if err != nil {
+			return nil, err
+		}
+		builder.method("UpdateDefault", expr)
+	}
 	if desc.Immutable {
 		builder.method("Immutable")
 	}
@@ -180,38 +695,62 @@ func fromSimpleType(desc *field.Descriptor) (*ast.CallExpr, error) {
 	if len(desc.SchemaType) > 0 {
 		builder.method("SchemaType", strMapLit(desc.SchemaType))
 	}
-	if len(desc.Annotations) != 0 {
-		annots, err := toAnnotASTs(desc.Annotations)
+	if hasGoType(desc) {
+		expr, _, err := goTypeExpr(desc.Info)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("%w of field %q", err, desc.Name)
 		}
-		builder.annotate(annots...)
+		builder.method("GoType", expr)
 	}
-	if desc.Default != nil {
-		expr, err := defaultExpr(desc.Default)
+	if len(desc.Annotations) != 0 {
+		annots, err := toAnnotASTs(desc.Annotations)
 		if err != nil {
 			return nil, err
 		}
-		builder.method("Default", expr)
+		builder.annotate(annots...)
 	}
 	// Unsupported features
 	var unsupported error
-	if len(desc.Validators) != 0 {
+	if !ok {
 		unsupported = combineUnsupported(unsupported, "Descriptor.Validators")
 	}
-	if desc.UpdateDefault != nil {
-		unsupported = combineUnsupported(unsupported, "Descriptor.UpdateDefault")
-	}
 	if unsupported != nil {
 		return nil, unsupported
 	}
 	return builder.curr, nil
 }
 
+// jsonHelpers maps the Go types of JSON fields to the constructors of the ent field package that
+// declare them without an explicit type argument.
+var jsonHelpers = map[string]string{
+	"[]string":  "Strings",
+	"[]int":     "Ints",
+	"[]float64": "Floats",
+}
+
+// jsonHelper returns the jsonHelpers constructor that declares the JSON field described by desc, or an
+// empty string if the field requires an explicit type argument.
+func jsonHelper(desc *field.Descriptor) string {
+	if desc.Info.RType == nil {
+		return ""
+	}
+	return jsonHelpers[desc.Info.Ident]
+}
+
 func fieldConstructor(dsc *field.Descriptor) string {
 	cn := dsc.Info.ConstName()
-	if dsc.Info.Type == field.TypeFloat64 {
+	switch dsc.Info.Type {
+	case field.TypeFloat64:
 		cn = strings.TrimSuffix(cn, "64")
+	case field.TypeString:
+		// field.Text is a string field with an unlimited size.
+		if dsc.Size == math.MaxInt32 {
+			return "Text"
+		}
+	case field.TypeJSON:
+		if name := jsonHelper(dsc); name != "" {
+			return name
+		}
 	}
 	return strings.TrimPrefix(cn, "Type")
 }
@@ -220,7 +759,7 @@ func defaultExpr(d interface{}) (ast.Expr, error) {
 	v := reflect.ValueOf(d)
 	switch v.Kind() {
 	case reflect.String:
-		return strLit(d.(string)), nil
+		return strLit(v.String()), nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
 		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		lit := &ast.BasicLit{
@@ -235,23 +774,49 @@ func defaultExpr(d interface{}) (ast.Expr, error) {
 		}
 		return lit, nil
 	case reflect.Bool:
-		lit := &ast.BasicLit{
-			Kind:  token.STRING,
-			Value: strconv.FormatBool(d.(bool)),
+		return ast.NewIdent(strconv.FormatBool(v.Bool())), nil
+	case reflect.Slice:
+		b, ok := d.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("schemast: unsupported default field kind: %q", v.Kind())
 		}
-		return lit, nil
+		return conversion(&ast.ArrayType{Elt: ast.NewIdent("byte")}, strLit(string(b))), nil
 	case reflect.Func:
-		f := runtime.FuncForPC(v.Pointer()).Name()
-		parts := strings.Split(f, ".")
-		if len(parts) != 2 {
-			return nil, errors.New("schemast: only selector exprs are supported for default func")
+		sel, _, err := funcSelector(d)
+		if err != nil {
+			return nil, err
 		}
-		return selectorLit(parts[0], parts[1]), nil
+		return sel, nil
 	default:
 		return nil, fmt.Errorf("schemast: unsupported default field kind: %q", v.Kind())
 	}
 }
 
+// fieldImports returns the import paths of the packages referenced by the AST that Field generates for desc.
+func fieldImports(desc *field.Descriptor) []string {
+	var paths []string
+	for _, d := range []interface{}{desc.Default, desc.UpdateDefault} {
+		if d == nil || reflect.TypeOf(d).Kind() != reflect.Func {
+			continue
+		}
+		if _, pkgPath, err := funcSelector(d); err == nil {
+			paths = append(paths, pkgPath)
+		}
+	}
+	validators, _ := validatorCalls(desc)
+	for _, v := range validators {
+		if v.pkgPath != "" {
+			paths = append(paths, v.pkgPath)
+		}
+	}
+	if desc.Info.RType != nil {
+		if _, pkgPath, err := goTypeExpr(desc.Info); err == nil && pkgPath != "" {
+			paths = append(paths, pkgPath)
+		}
+	}
+	return append(paths, annotationImports(desc.Annotations)...)
+}
+
 func extractFieldName(fd *ast.CallExpr) (string, error) {
 	sel, ok := fd.Fun.(*ast.SelectorExpr)
 	if !ok {
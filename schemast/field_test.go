@@ -16,17 +16,27 @@ package schemast
 
 import (
 	"bytes"
+	"go/ast"
+	"go/parser"
 	"go/printer"
 	"go/token"
+	"reflect"
+	"strconv"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"entgo.io/contrib/entproto"
+	"entgo.io/contrib/schemast/internal/sharedtest/v2"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 func TestFromFieldDescriptor(t *testing.T) {
@@ -61,6 +71,26 @@ func TestFromFieldDescriptor(t *testing.T) {
 			field:    field.Time("time").Default(time.Now),
 			expected: `field.Time("time").Default(time.Now)`,
 		},
+		{
+			name:     "time created_at",
+			field:    field.Time("created_at").Default(time.Now).Immutable(),
+			expected: `field.Time("created_at").Default(time.Now).Immutable()`,
+		},
+		{
+			name:     "time updated_at",
+			field:    field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+			expected: `field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now)`,
+		},
+		{
+			name:     "package name differs from path",
+			field:    field.Time("created_at").Default(sharedtest.Now),
+			expected: `field.Time("created_at").Default(sharedtest.Now)`,
+		},
+		{
+			name:     "update default package name differs from path",
+			field:    field.Time("updated_at").Default(time.Now).UpdateDefault(sharedtest.Now),
+			expected: `field.Time("updated_at").Default(time.Now).UpdateDefault(sharedtest.Now)`,
+		},
 		{
 			name: "time anonymous",
 			field: field.Time("time").Default(func() time.Time {
@@ -68,6 +98,18 @@ func TestFromFieldDescriptor(t *testing.T) {
 			}),
 			expectedErrMsg: "schemast: only selector exprs are supported for default func",
 		},
+		{
+			name:     "update default",
+			field:    field.Int("version").Default(1).UpdateDefault(incrementVersion),
+			expected: `field.Int("version").Default(1).UpdateDefault(schemast.incrementVersion)`,
+		},
+		{
+			name: "update default anonymous",
+			field: field.Int("version").Default(1).UpdateDefault(func() int {
+				return 1
+			}),
+			expectedErrMsg: "schemast: only selector exprs are supported for default func",
+		},
 		{
 			name:     "struct tag",
 			field:    field.String("x").StructTag(`j:"hi"`),
@@ -84,6 +126,11 @@ func TestFromFieldDescriptor(t *testing.T) {
 			field:    field.Enum("x").NamedValues("a", "b"),
 			expected: `field.Enum("x").NamedValues("a", "b")`,
 		},
+		{
+			name:     "enums:named values pairs",
+			field:    field.Enum("x").NamedValues("Active", "active", "Inactive", "inactive", "Pending", "pending"),
+			expected: `field.Enum("x").NamedValues("Active", "active", "Inactive", "inactive", "Pending", "pending")`,
+		},
 		{
 			name:     "storage key",
 			field:    field.String("x").StorageKey("s"),
@@ -96,11 +143,66 @@ func TestFromFieldDescriptor(t *testing.T) {
 			}),
 			expected: `field.String("x").SchemaType(map[string]string{"sqlite3": "VARCHAR"})`,
 		},
+		{
+			name:     "schema type:int8",
+			field:    field.Int8("flags").SchemaType(map[string]string{dialect.Postgres: "smallint"}),
+			expected: `field.Int8("flags").SchemaType(map[string]string{"postgres": "smallint"})`,
+		},
+		{
+			name:     "schema type:int16",
+			field:    field.Int16("flags").SchemaType(map[string]string{dialect.Postgres: "smallint", dialect.MySQL: "smallint"}),
+			expected: `field.Int16("flags").SchemaType(map[string]string{"mysql": "smallint", "postgres": "smallint"})`,
+		},
+		{
+			name:     "schema type:int32",
+			field:    field.Int32("flags").SchemaType(map[string]string{dialect.Postgres: "integer"}),
+			expected: `field.Int32("flags").SchemaType(map[string]string{"postgres": "integer"})`,
+		},
 		{
 			name:     "annotations",
 			field:    field.String("x").Annotations(entproto.Message()),
 			expected: `field.String("x").Annotations(entproto.Message())`,
 		},
+		{
+			name:     "annotations:proto",
+			field:    field.Enum("status").Values("active").Annotations(entproto.Field(3), entproto.Enum(map[string]int32{"active": 1})),
+			expected: `field.Enum("status").Values("active").Annotations(entproto.Field(3), entproto.Enum(map[string]int32{"active": 1}))`,
+		},
+		{
+			name:     "annotations:proto type",
+			field:    field.Uint8("custom_pb").Annotations(entproto.Field(2, entproto.Type(descriptorpb.FieldDescriptorProto_TYPE_UINT64))),
+			expected: `field.Uint8("custom_pb").Annotations(entproto.Field(2, entproto.Type(descriptorpb.FieldDescriptorProto_TYPE_UINT64)))`,
+		},
+		{
+			name:     "sensitive",
+			field:    field.String("password").Sensitive(),
+			expected: `field.String("password").Sensitive()`,
+		},
+		{
+			name:     "sensitive with modifiers",
+			field:    field.String("token").Sensitive().Optional().NotEmpty().Comment("API token."),
+			expected: `field.String("token").Optional().Sensitive().NotEmpty().Comment("API token.")`,
+		},
+		{
+			name:     "bytes with modifiers",
+			field:    field.Bytes("payload").Optional().Nillable().MaxLen(1 << 16),
+			expected: `field.Bytes("payload").Nillable().Optional().MaxLen(65536)`,
+		},
+		{
+			name:     "text",
+			field:    field.Text("body").Optional(),
+			expected: `field.Text("body").Optional()`,
+		},
+		{
+			name:     "text with max length",
+			field:    field.Text("body").MaxLen(1024),
+			expected: `field.String("body").MaxLen(1024)`,
+		},
+		{
+			name:     "default:bytes",
+			field:    field.Bytes("payload").Default([]byte("{}")),
+			expected: `field.Bytes("payload").Default([]byte("{}"))`,
+		},
 		{
 			name:     "default:string",
 			field:    field.String("x").Default("x"),
@@ -136,6 +238,26 @@ func TestFromFieldDescriptor(t *testing.T) {
 			field:    field.Bool("x").Default(true),
 			expected: `field.Bool("x").Default(true)`,
 		},
+		{
+			name:     "default:bool false",
+			field:    field.Bool("x").Default(false),
+			expected: `field.Bool("x").Default(false)`,
+		},
+		{
+			name:     "default:negative int",
+			field:    field.Int64("x").Default(-10),
+			expected: `field.Int64("x").Default(-10)`,
+		},
+		{
+			name:     "default:quoted string",
+			field:    field.String("x").Default(`say "hi"`),
+			expected: `field.String("x").Default("say \"hi\"")`,
+		},
+		{
+			name:     "default:enum",
+			field:    field.Enum("x").Values("a", "b").Default("b"),
+			expected: `field.Enum("x").Values("a", "b").Default("b")`,
+		},
 		{
 			name: "unsupported validator",
 			field: field.String("x").Validate(func(s string) error {
@@ -153,6 +275,11 @@ func TestFromFieldDescriptor(t *testing.T) {
 			field:    field.UUID("x", uuid.UUID{}),
 			expected: `field.UUID("x", uuid.UUID{})`,
 		},
+		{
+			name:     "uuid with modifiers",
+			field:    field.UUID("x", uuid.UUID{}).Default(uuid.New).Unique(),
+			expected: `field.UUID("x", uuid.UUID{}).Unique().Default(uuid.New)`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -172,6 +299,14 @@ func TestFromFieldDescriptor(t *testing.T) {
 	}
 }
 
+func randomToken() string {
+	return "token"
+}
+
+func incrementVersion() int {
+	return 1
+}
+
 type annotation string
 
 func (a annotation) Name() string { return string(a) }
@@ -187,7 +322,8 @@ func TestAppendField(t *testing.T) {
 			expectedBody: `// Fields of the WithFields.
 func (WithFields) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("existing"), field.String("newField"),
+		field.String("existing"),
+		field.String("newField"),
 	}
 }`,
 		},
@@ -202,6 +338,16 @@ func (WithNilFields) Fields() []ent.Field {
 			typeName: "WithoutFields",
 			expectedBody: `func (WithoutFields) Fields() []ent.Field {
 	return []ent.Field{field.String("newField")}
+}`,
+		},
+		{
+			typeName: "WithSplitFields",
+			expectedBody: `// Fields of the WithSplitFields.
+func (WithSplitFields) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("existing"),
+		field.String("newField"),
+	}
 }`,
 		},
 	}
@@ -225,6 +371,230 @@ func (WithNilFields) Fields() []ent.Field {
 	}
 }
 
+func TestFieldCommentRoundTrip(t *testing.T) {
+	comment := "Uses `backticks` and \"quotes\".\nSpans multiple lines."
+	r, err := Field(field.String("x").Comment(comment).StructTag(`json:"x"`).Descriptor())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+	require.EqualValues(t, `field.String("x").Comment("Uses `+"`backticks`"+` and \"quotes\".\nSpans multiple lines.").StructTag("json:\"x\"")`, buf.String())
+
+	expr, err := parser.ParseExpr(buf.String())
+	require.NoError(t, err)
+	call := expr.(*ast.CallExpr).Fun.(*ast.SelectorExpr).X.(*ast.CallExpr)
+	require.EqualValues(t, "Comment", call.Fun.(*ast.SelectorExpr).Sel.Name)
+	got, err := strconv.Unquote(call.Args[0].(*ast.BasicLit).Value)
+	require.NoError(t, err)
+	require.EqualValues(t, comment, got)
+}
+
+func TestFieldBoolStructTagOptional(t *testing.T) {
+	tag := `json:"active,omitempty"`
+	r, err := Field(field.Bool("active").StructTag(tag).Optional().Descriptor())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+	require.EqualValues(t, `field.Bool("active").Optional().StructTag("json:\"active,omitempty\"")`, buf.String())
+
+	expr, err := parser.ParseExpr(buf.String())
+	require.NoError(t, err)
+	call := expr.(*ast.CallExpr)
+	require.EqualValues(t, "StructTag", call.Fun.(*ast.SelectorExpr).Sel.Name)
+	got, err := strconv.Unquote(call.Args[0].(*ast.BasicLit).Value)
+	require.NoError(t, err)
+	require.EqualValues(t, tag, got)
+}
+
+func TestAppendFieldExpr(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	expr, err := ctx.AppendFieldExpr("WithFields", field.String("name").Optional().Descriptor())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), expr))
+	require.EqualValues(t, `field.String("name").Optional()`, buf.String())
+}
+
+func TestAppendFieldLinter(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	ctx.AddFieldLinter(func(_ string, desc *field.Descriptor) []string {
+		if desc.Unique && desc.Default != nil && reflect.TypeOf(desc.Default).Kind() == reflect.Func {
+			return []string{"unique field with a non-deterministic default"}
+		}
+		return nil
+	})
+	require.NoError(t, ctx.AppendField("WithFields", field.String("name").Unique().Descriptor()))
+	require.Empty(t, ctx.Warnings())
+	require.NoError(t, ctx.AppendField("WithFields", field.String("token").Unique().DefaultFunc(randomToken).Descriptor()))
+	require.EqualValues(t, []string{"WithFields.token: unique field with a non-deterministic default"}, ctx.Warnings())
+	require.True(t, ctx.HasField("WithFields", "token"))
+}
+
+func TestAppendFieldImports(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	err = ctx.AppendField("WithFields", field.Int("version").Default(1).UpdateDefault(incrementVersion).Descriptor())
+	require.NoError(t, err)
+	file, _, ok := ctx.lookupTypeDecl("WithFields")
+	require.True(t, ok)
+	var paths []string
+	for _, imp := range file.Imports {
+		paths = append(paths, imp.Path.Value)
+	}
+	require.Contains(t, paths, `"entgo.io/contrib/schemast"`)
+
+	require.NoError(t, ctx.AppendField("WithFields", field.Time("created_at").Default(sharedtest.Now).Descriptor()))
+	file, _, _ = ctx.lookupTypeDecl("WithFields")
+	for _, imp := range file.Imports {
+		if imp.Path.Value == `"entgo.io/contrib/schemast/internal/sharedtest/v2"` {
+			require.Nil(t, imp.Name)
+			return
+		}
+	}
+	t.Fatal("expected an import of the sharedtest/v2 package")
+}
+
+func TestAppendFieldKeepsComments(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddType("Pet"))
+	l, err := tt.ctx.returnedLiteral(kindField, "Pet")
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.rewrite(l, []literalEntry{
+		{text: `field.String("name"), // The name of the pet.`},
+		{text: "// Extra fields."},
+	}))
+	require.NoError(t, tt.ctx.AppendField("Pet", field.Int("age").Descriptor()))
+	require.NoError(t, tt.ctx.AppendField("Pet", field.Bool("vaccinated").Optional().Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("pet.go"), `return []ent.Field{
+		field.String("name"), // The name of the pet.
+		// Extra fields.
+		field.Int("age"),
+		field.Bool("vaccinated").Optional(),
+	}`)
+	require.Len(t, tt.getType("Pet").Fields, 3)
+}
+
+func TestAppendFieldLenient(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	desc := field.String("name").
+		Validate(func(string) error { return nil }).
+		Annotations(annotation("Custom"), entproto.Field(2)).
+		Default("unknown").
+		Descriptor()
+	err = tt.ctx.AppendField("User", desc)
+	require.EqualError(t, err, `schemast: no Annotator configured for annotation "Custom"`)
+	tt.ctx.Lenient = true
+	require.NoError(t, tt.ctx.AppendField("User", desc))
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{
+		Name: "Message",
+		Fields: []ent.Field{
+			field.Int("priority").Validate(func(int) error { return nil }),
+		},
+	}))
+	require.EqualValues(t, []string{
+		`User.name: dropped unsupported feature Descriptor.Annotations (Custom)`,
+		`User.name: dropped unsupported feature Descriptor.Validators`,
+		`Message.priority: dropped unsupported feature Descriptor.Validators`,
+	}, tt.ctx.Warnings())
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `field.String("name").Default("unknown").Annotations(entproto.Field(2))`)
+	require.Len(t, tt.getType("Message").Fields, 1)
+}
+
+func TestAppendFieldReservedName(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	err = ctx.AppendField("WithFields", field.String("type").Descriptor())
+	require.EqualError(t, err, `schemast: field name "type" conflicts with Go keyword`)
+	err = ctx.AppendField("WithFields", field.String("edges").Descriptor())
+	require.EqualError(t, err, `schemast: field name "edges" conflicts with ent reserved identifier`)
+}
+
+func TestDeprecateField(t *testing.T) {
+	tests := []struct {
+		name     string
+		typeName string
+		field    string
+		annots   []schema.Annotation
+		expected string
+	}{
+		{
+			name:     "comment",
+			typeName: "WithModifiedField",
+			field:    "name",
+			expected: `	return []ent.Field{
+		// Deprecated: use nickname instead.
+		field.String("name").NotEmpty().Immutable().MaxLen(10),
+	}`,
+		},
+		{
+			name:     "annotation",
+			typeName: "WithFields",
+			field:    "existing",
+			annots:   []schema.Annotation{entproto.Field(2)},
+			expected: `	return []ent.Field{
+		// Deprecated: use nickname instead.
+		field.String("existing").Annotations(entproto.Field(2)),
+	}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := Load("./internal/mutatetest/ent/schema")
+			require.NoError(t, err)
+			require.NoError(t, ctx.DeprecateField(tt.typeName, tt.field, "use nickname instead.", tt.annots...))
+			var buf bytes.Buffer
+			require.NoError(t, printer.Fprint(&buf, ctx.SchemaPackage.Fset, ctx.methodFile(tt.typeName, "Fields")))
+			require.Contains(t, buf.String(), tt.expected)
+			require.True(t, ctx.HasField(tt.typeName, tt.field))
+		})
+	}
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	err = ctx.DeprecateField("WithFields", "non_existent", "note")
+	require.EqualError(t, err, `schemast: could not find field "non_existent" in type "WithFields"`)
+}
+
+func TestContext_FieldType(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, ctx.AddType("Pet"))
+	fields := []ent.Field{
+		field.String("name"),
+		field.Int64("age"),
+		field.Float("weight"),
+		field.Enum("kind").Values("cat", "dog"),
+		field.JSON("tags", []string{}),
+		field.Strings("aliases"),
+		field.Time("born_at"),
+		field.UUID("uuid", uuid.UUID{}),
+	}
+	for _, f := range fields {
+		require.NoError(t, ctx.AppendField("Pet", f.Descriptor()))
+	}
+	for _, f := range fields {
+		typ, err := ctx.FieldType("Pet", f.Descriptor().Name)
+		require.NoError(t, err)
+		require.EqualValues(t, f.Descriptor().Info.Type, typ)
+	}
+	_, err = ctx.FieldType("Pet", "non_existent")
+	require.EqualError(t, err, `schemast: could not find field "non_existent" in type "Pet"`)
+
+	custom := fnCall(selectorLit("field", "Custom"), strLit("custom"))
+	_, err = ctx.appendReturnItem(kindField, "Pet", custom)
+	require.NoError(t, err)
+	_, err = ctx.FieldType("Pet", "custom")
+	require.EqualError(t, err, `schemast: unrecognized field constructor "Custom" for field "custom"`)
+}
+
 func TestRemoveField(t *testing.T) {
 	ctx, err := Load("./internal/mutatetest/ent/schema")
 	require.NoError(t, err)
@@ -241,3 +611,236 @@ func TestRemoveField(t *testing.T) {
 	return []ent.Field{}
 }`, buf.String())
 }
+
+func TestUpdateField(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendField("User", field.String("name").Descriptor()))
+	require.NoError(t, tt.ctx.AppendField("User", field.Int("age").Descriptor(), InGroup("Profile")))
+	require.NoError(t, tt.ctx.AppendField("User", field.String("bio").Descriptor(), InGroup("Profile")))
+	require.NoError(t, tt.ctx.UpdateField("User", field.Int("age").Optional().Positive().Descriptor()))
+	require.NoError(t, tt.ctx.UpdateField("User", field.Time("name").Descriptor()))
+	err = tt.ctx.UpdateField("User", field.String("email").Descriptor())
+	require.EqualError(t, err, `schemast: could not find field "email" in type "User"`)
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `return []ent.Field{
+		field.Time("name"),
+		// --- Profile ---
+		field.Int("age").Optional().Positive(),
+		field.String("bio"),
+	}`)
+	user := tt.getType("User")
+	require.Len(t, user.Fields, 3)
+	require.EqualValues(t, "time.Time", user.Fields[0].Type.String())
+	require.True(t, user.Fields[1].Optional)
+}
+
+func TestInsertMoveField(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.InsertField("User", field.String("name").Descriptor(), 0))
+	require.NoError(t, tt.ctx.AppendField("User", field.Int("age").Descriptor(), InGroup("Profile")))
+	require.NoError(t, tt.ctx.DeprecateField("User", "age", "use birthday."))
+	require.NoError(t, tt.ctx.InsertField("User", field.String("email").Descriptor(), 1))
+	require.NoError(t, tt.ctx.InsertField("User", field.Time("birthday").Descriptor(), 3))
+	err = tt.ctx.InsertField("User", field.String("bio").Descriptor(), 5)
+	require.EqualError(t, err, "schemast: index 5 is out of range [0, 4]")
+	require.NoError(t, tt.ctx.MoveField("User", "age", 3))
+	require.NoError(t, tt.ctx.MoveField("User", "name", 1))
+	err = tt.ctx.MoveField("User", "bio", 0)
+	require.EqualError(t, err, `schemast: could not find field "bio" in type "User"`)
+	err = tt.ctx.MoveField("User", "name", 4)
+	require.EqualError(t, err, "schemast: index 4 is out of range [0, 3]")
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `return []ent.Field{
+		field.String("email"),
+		field.String("name"),
+		// --- Profile ---
+		field.Time("birthday"),
+		// Deprecated: use birthday.
+		field.Int("age"),
+	}`)
+	var names []string
+	for _, f := range tt.getType("User").Fields {
+		names = append(names, f.Name)
+	}
+	require.EqualValues(t, []string{"email", "name", "birthday", "age"}, names)
+}
+
+func TestRenameField(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{
+		Name: "Membership",
+		Fields: []ent.Field{
+			field.Int("user_id"),
+			field.Int("team_id"),
+			field.String("role"),
+		},
+		Edges: []ent.Edge{
+			WithType(edge.To("user", placeholder.Type).Unique().Required().Field("user_id"), "User"),
+			WithType(edge.To("team", placeholder.Type).Unique().Required().Field("team_id"), "Message"),
+		},
+		Indexes: []ent.Index{
+			index.Fields("role", "team_id"),
+		},
+		Annotations: []schema.Annotation{
+			field.ID("user_id", "team_id"),
+		},
+	}))
+	require.NoError(t, tt.ctx.RenameField("Membership", "team_id", "message_id"))
+	err = tt.ctx.RenameField("Membership", "team_id", "other_id")
+	require.EqualError(t, err, `schemast: could not find field "team_id" in type "Membership"`)
+	err = tt.ctx.RenameField("Membership", "role", "user_id")
+	require.EqualError(t, err, `schemast: field "user_id" already exists in type "Membership"`)
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("membership.go")
+	require.Contains(t, contents, `field.Int("message_id")`)
+	require.Contains(t, contents, `index.Fields("role", "message_id")`)
+	require.Contains(t, contents, `field.ID("user_id", "message_id")`)
+	require.NotContains(t, contents, "team_id")
+	membership := tt.getType("Membership")
+	for _, e := range membership.Edges {
+		if e.Name == "team" {
+			require.EqualValues(t, "message_id", e.Field().Name)
+		}
+	}
+	require.EqualValues(t, "message_id", membership.Indexes[0].Columns[1])
+}
+
+func TestRenameFieldNonLiteral(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, ctx.AddType("Team"))
+	require.NoError(t, ctx.AppendField("Team", field.String("name").Descriptor()))
+	require.NoError(t, ctx.AddMethod("Team", "func (Team) Indexes() []ent.Index {\n\treturn []ent.Index{index.Fields(nameField)}\n}"))
+	err = ctx.RenameField("Team", "name", "title")
+	require.EqualError(t, err, `schemast: cannot rename field "name" of type "Team", the Indexes method references fields with a non-literal expression`)
+	require.True(t, ctx.HasField("Team", "name"))
+}
+
+func TestContext_EnumValues(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, ctx.AddType("Ticket"))
+	fields := []ent.Field{
+		field.Enum("status").Values("open", "closed").Default("open").Comment("The ticket status."),
+		field.Enum("priority").NamedValues("Low", "LOW", "High", "HIGH").Optional(),
+		field.String("title"),
+	}
+	for _, f := range fields {
+		require.NoError(t, ctx.AppendField("Ticket", f.Descriptor()))
+	}
+	require.NoError(t, ctx.AppendEnumValue("Ticket", "status", "pending"))
+	require.NoError(t, ctx.RemoveEnumValue("Ticket", "status", "open"))
+	require.NoError(t, ctx.AppendEnumValue("Ticket", "priority", "MEDIUM"))
+	require.NoError(t, ctx.RemoveEnumValue("Ticket", "priority", "LOW"))
+
+	err = ctx.AppendEnumValue("Ticket", "status", "closed")
+	require.EqualError(t, err, `schemast: enum field "status" of type "Ticket" already has value "closed"`)
+	err = ctx.RemoveEnumValue("Ticket", "status", "open")
+	require.EqualError(t, err, `schemast: enum field "status" of type "Ticket" has no value "open"`)
+	err = ctx.AppendEnumValue("Ticket", "title", "x")
+	require.EqualError(t, err, `schemast: field "title" of type "Ticket" is not an enum`)
+	err = ctx.RemoveEnumValue("Ticket", "non_existent", "x")
+	require.EqualError(t, err, `schemast: could not find field "non_existent" in type "Ticket"`)
+
+	var buf bytes.Buffer
+	method, _ := ctx.lookupMethod("Ticket", "Fields")
+	require.NoError(t, printer.Fprint(&buf, ctx.SchemaPackage.Fset, method))
+	require.EqualValues(t, `func (Ticket) Fields() []ent.Field {
+	return []ent.Field{field.Enum("status").Values("closed", "pending").Default("open").Comment("The ticket status."), field.Enum("priority").NamedValues("High", "HIGH", "MEDIUM", "MEDIUM").Optional(), field.String("title")}
+}`, buf.String())
+}
+
+func TestNonLiteralFields(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	ctx, err := LoadFS(fstest.MapFS{
+		"schema/pet.go": {Data: []byte(`package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+type Pet struct {
+	ent.Schema
+}
+
+func (Pet) Fields() []ent.Field {
+	return append(baseFields(), []ent.Field{
+		field.String("name"),
+	}...)
+}
+
+func baseFields() []ent.Field {
+	return []ent.Field{
+		field.Int("age"),
+	}
+}
+
+type Group struct {
+	ent.Schema
+}
+
+func (Group) Fields() []ent.Field {
+	fields := []ent.Field{
+		field.String("name"),
+	}
+	return fields
+}
+
+type Tag struct {
+	ent.Schema
+}
+
+func (Tag) Fields() []ent.Field {
+	return append(baseFields(), field.String("label"))
+}
+
+type Label struct {
+	ent.Schema
+}
+
+func (Label) Fields() []ent.Field {
+	fields := []ent.Field{
+		field.String("name"),
+	}
+	fields = append(fields, field.String("color"))
+	return fields
+}
+`)},
+	}, "schema/*.go")
+	require.NoError(t, err)
+	require.True(t, ctx.HasField("Pet", "name"))
+	require.True(t, ctx.HasField("Group", "name"))
+	require.NoError(t, ctx.AppendField("Pet", field.String("nickname").Descriptor()))
+	require.NoError(t, ctx.AppendField("Group", field.String("nickname").Descriptor()))
+	require.NoError(t, ctx.RemoveField("Group", "name"))
+	err = ctx.AppendField("Tag", field.String("nickname").Descriptor())
+	require.EqualError(t, err, `schemast: schema/pet.go:42:9: the Fields method of type "Tag" returns an expression that is not supported, `+
+		`expected a slice literal, an append call whose last argument is a slice literal, or a variable assigned a slice literal`)
+	require.False(t, ctx.HasField("Label", "color"))
+	err = ctx.AppendField("Label", field.String("nickname").Descriptor())
+	require.EqualError(t, err, `schemast: schema/pet.go:53:2: the Fields method of type "Label" reassigns the returned variable fields, `+
+		`expected a variable assigned a slice literal once`)
+	tt.ctx = ctx
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	var fields []string
+	for _, f := range tt.getType("Pet").Fields {
+		fields = append(fields, f.Name)
+	}
+	require.EqualValues(t, []string{"age", "name", "nickname"}, fields)
+	require.Len(t, tt.getType("Group").Fields, 1)
+	require.EqualValues(t, "nickname", tt.getType("Group").Fields[0].Name)
+	require.Contains(t, tt.contents("pet.go"), "\treturn fields\n")
+}
@@ -0,0 +1,153 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"net/url"
+	"path"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// funcs holds the function references registered with RegisterFunc, keyed by function pointer.
+var funcs = struct {
+	sync.RWMutex
+	refs map[uintptr]string
+}{refs: make(map[uintptr]string)}
+
+// RegisterFunc teaches schemast to reference the function fn as ref in generated code, for example
+// when fn is used as the DefaultFunc of a field. ref is the import path of the package followed by
+// the function name, e.g. "github.com/acme/ids.New", and the package is imported where ref is used.
+//
+// Package-level functions are resolved automatically and only need to be registered when the generated
+// code should reference them differently. Closures cannot be resolved, and since all closures created
+// by the same function literal share a pointer, registering one of them registers all of them.
+func RegisterFunc(fn interface{}, ref string) error {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return fmt.Errorf("schemast: expected a func to register, got %T", fn)
+	}
+	if _, _, ok := splitFuncName(ref); !ok {
+		return fmt.Errorf("schemast: expected func reference of form \"importpath.Name\", got %q", ref)
+	}
+	funcs.Lock()
+	defer funcs.Unlock()
+	funcs.refs[v.Pointer()] = ref
+	return nil
+}
+
+// RegisterValidator is like RegisterFunc, but fn must be a field validator, i.e. a function that receives
+// a single value and returns an error. Fields using fn as a validator are generated with a .Validate(ref)
+// call instead of failing with an unsupported feature error.
+// Example:
+//
+//	schemast.RegisterValidator(mypkg.ValidateSlug, "github.com/acme/mypkg.ValidateSlug")
+func RegisterValidator(fn interface{}, ref string) error {
+	t := reflect.TypeOf(fn)
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 1 || t.Out(0) != errorType {
+		return fmt.Errorf("schemast: expected a validator of form func(T) error, got %T", fn)
+	}
+	return RegisterFunc(fn, ref)
+}
+
+// errorType is the reflect.Type of the error interface.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// funcSelector returns a selector expression referencing the function fn, along with the import path
+// of the package that declares it. The selector is qualified by the name of the package, which may differ
+// from the last element of its import path, e.g. for gopkg.in/yaml.v3 or major version suffixes.
+func funcSelector(fn interface{}) (*ast.SelectorExpr, string, error) {
+	ptr := reflect.ValueOf(fn).Pointer()
+	funcs.RLock()
+	name, ok := funcs.refs[ptr]
+	funcs.RUnlock()
+	if !ok {
+		name = runtime.FuncForPC(ptr).Name()
+	}
+	pkgPath, ident, ok := splitFuncName(name)
+	if !ok {
+		return nil, "", errors.New("schemast: only selector exprs are supported for default func")
+	}
+	return selectorLit(packageName(pkgPath), ident), pkgPath, nil
+}
+
+// pkgNames caches the names of the packages resolved by packageName, keyed by import path.
+var pkgNames = struct {
+	sync.Mutex
+	names map[string]string
+}{names: make(map[string]string)}
+
+// packageName returns the name of the package with the import path pkgPath. Packages that cannot be
+// loaded, e.g. the packages of functions registered with RegisterFunc that are not dependencies of the
+// current module, are assumed to be named after their import path, as goimports does.
+func packageName(pkgPath string) string {
+	pkgNames.Lock()
+	defer pkgNames.Unlock()
+	if name, ok := pkgNames.names[pkgPath]; ok {
+		return name
+	}
+	name := assumedPackageName(pkgPath)
+	if pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName}, pkgPath); err == nil &&
+		len(pkgs) == 1 && len(pkgs[0].Errors) == 0 && pkgs[0].Name != "" {
+		name = pkgs[0].Name
+	}
+	pkgNames.names[pkgPath] = name
+	return name
+}
+
+// assumedPackageName returns the name of the package with the import path pkgPath, assuming it is the
+// last element of the path without its major version suffix, "go-" prefix or non-identifier suffix, e.g.
+// "yaml" for gopkg.in/yaml.v3, github.com/goccy/go-yaml or github.com/acme/yaml/v2.
+func assumedPackageName(pkgPath string) string {
+	base := path.Base(pkgPath)
+	if strings.HasPrefix(base, "v") {
+		if _, err := strconv.Atoi(base[1:]); err == nil && path.Dir(pkgPath) != "." {
+			base = path.Base(path.Dir(pkgPath))
+		}
+	}
+	base = strings.TrimPrefix(base, "go-")
+	if i := strings.IndexFunc(base, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}); i != -1 {
+		base = base[:i]
+	}
+	return base
+}
+
+// splitFuncName splits the qualified name of a package-level function into the import path of the
+// package and the name of the function. It reports false if name does not reference a package-level
+// function, e.g. for closures and methods. The runtime escapes the dots of the last element of import
+// paths, e.g. gopkg.in/yaml%2ev3.Marshal, which are unescaped in the returned path.
+func splitFuncName(name string) (string, string, bool) {
+	pkgEnd := strings.LastIndex(name, "/") + 1
+	dot := strings.Index(name[pkgEnd:], ".")
+	if dot == -1 || strings.Contains(name[pkgEnd+dot+1:], ".") {
+		return "", "", false
+	}
+	pkgPath, err := url.PathUnescape(name[:pkgEnd+dot])
+	if err != nil {
+		return "", "", false
+	}
+	return pkgPath, name[pkgEnd+dot+1:], true
+}
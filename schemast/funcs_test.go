@@ -0,0 +1,125 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"bytes"
+	"go/printer"
+	"go/token"
+	"testing"
+
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestRegisterFunc(t *testing.T) {
+	newID := func() string {
+		return "id"
+	}
+	_, err := Field(field.String("x").DefaultFunc(newID).Descriptor())
+	require.EqualError(t, err, "schemast: only selector exprs are supported for default func")
+
+	require.NoError(t, RegisterFunc(newID, "github.com/acme/ids.New"))
+	r, err := Field(field.String("x").DefaultFunc(newID).Descriptor())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+	require.EqualValues(t, `field.String("x").Default(ids.New)`, buf.String())
+
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, ctx.AppendField("WithFields", field.String("ref").DefaultFunc(newID).Descriptor()))
+	file := ctx.methodFile("WithFields", "Fields")
+	var imports []string
+	for _, imp := range file.Imports {
+		imports = append(imports, imp.Path.Value)
+	}
+	require.Contains(t, imports, `"github.com/acme/ids"`)
+
+	require.EqualError(t, RegisterFunc("New", "ids.New"), "schemast: expected a func to register, got string")
+	require.EqualError(t, RegisterFunc(newID, "New"), `schemast: expected func reference of form "importpath.Name", got "New"`)
+}
+
+func TestRegisterValidator(t *testing.T) {
+	slug := func(s string) error {
+		return nil
+	}
+	require.NoError(t, RegisterValidator(slug, "github.com/acme/mypkg.ValidateSlug"))
+	tests := []struct {
+		name     string
+		field    *field.Descriptor
+		expected string
+	}{
+		{
+			name:     "registered",
+			field:    field.String("slug").Validate(slug).Descriptor(),
+			expected: `field.String("slug").Validate(mypkg.ValidateSlug)`,
+		},
+		{
+			name:     "package-level",
+			field:    field.Int("count").Validate(validateCount).Optional().Descriptor(),
+			expected: `field.Int("count").Optional().Validate(schemast.validateCount)`,
+		},
+		{
+			name:     "multiple",
+			field:    field.String("slug").Validate(slug).Validate(slug).Descriptor(),
+			expected: `field.String("slug").Validate(mypkg.ValidateSlug).Validate(mypkg.ValidateSlug)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Field(tt.field)
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+			require.EqualValues(t, tt.expected, buf.String())
+		})
+	}
+	require.Contains(t, fieldImports(field.String("slug").Validate(slug).Descriptor()), "github.com/acme/mypkg")
+
+	err := RegisterValidator(func(s string) bool { return true }, "github.com/acme/mypkg.IsSlug")
+	require.EqualError(t, err, "schemast: expected a validator of form func(T) error, got func(string) bool")
+}
+
+func validateCount(int) error {
+	return nil
+}
+
+func TestAssumedPackageName(t *testing.T) {
+	for pkgPath, name := range map[string]string{
+		"time":                     "time",
+		"github.com/acme/ids":      "ids",
+		"github.com/acme/ids/v2":   "ids",
+		"gopkg.in/yaml.v3":         "yaml",
+		"github.com/goccy/go-yaml": "yaml",
+	} {
+		require.Equal(t, name, assumedPackageName(pkgPath), pkgPath)
+	}
+}
+
+func TestFuncSelectorDottedPath(t *testing.T) {
+	sel, pkgPath, err := funcSelector(yaml.Marshal)
+	require.NoError(t, err)
+	require.Equal(t, "gopkg.in/yaml.v3", pkgPath)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), sel))
+	require.Equal(t, "yaml.Marshal", buf.String())
+
+	pkgPath, name, ok := splitFuncName("gopkg.in/yaml%2ev3.Marshal")
+	require.True(t, ok)
+	require.Equal(t, "gopkg.in/yaml.v3", pkgPath)
+	require.Equal(t, "Marshal", name)
+}
@@ -0,0 +1,377 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/schema/field"
+)
+
+// goTypeExpr returns an expression of a value of the Go type of a field, as set by its constructor (e.g.
+// field.JSON) or its GoType method, along with the import path of the package that declares it. The type is
+// built from the exported fields of its RType, which expose the import path of a single package. Types that
+// reference named types of several packages are reported as errors.
+func goTypeExpr(info *field.TypeInfo) (ast.Expr, string, error) {
+	if info == nil || info.RType == nil {
+		return nil, "", fmt.Errorf("schemast: missing Go type")
+	}
+	rt := info.RType
+	// The identifier of RType is the one of the type, or of its element type for pointers.
+	typ, err := parser.ParseExpr(rt.Ident)
+	if err != nil {
+		return nil, "", fmt.Errorf("schemast: could not parse the Go type %s: %w", rt.Ident, err)
+	}
+	clearPos(typ)
+	if rt.Kind == reflect.Ptr {
+		typ = &ast.StarExpr{X: typ}
+	}
+	pkgs := make(map[string]bool)
+	ast.Inspect(typ, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok {
+				pkgs[x.Name] = true
+			}
+			return false
+		}
+		return true
+	})
+	pkgPath := rt.PkgPath
+	if pkgPath == "" {
+		// Composite JSON types expose the package of their element types only in the TypeInfo.
+		pkgPath = info.PkgPath
+	}
+	switch {
+	case len(pkgs) > 1:
+		return nil, "", fmt.Errorf("schemast: could not resolve the packages of the Go type %s", info)
+	case len(pkgs) == 1 && pkgPath == "":
+		return nil, "", fmt.Errorf("schemast: could not resolve the package of the Go type %s", info)
+	case len(pkgs) == 0:
+		pkgPath = ""
+	}
+	expr, err := zeroValue(typ, rt.Kind)
+	if err != nil {
+		return nil, "", err
+	}
+	return expr, pkgPath, nil
+}
+
+// clearPos resets the positions of the nodes of a parsed expression, which refer to a file set that is not
+// the one of the printed file.
+func clearPos(expr ast.Expr) {
+	posType := reflect.TypeOf(token.NoPos)
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		v := reflect.ValueOf(n).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.Type() == posType {
+				f.Set(reflect.ValueOf(token.NoPos))
+			}
+		}
+		// Empty field lists are printed on a single line, e.g. in struct{}.
+		switch n := n.(type) {
+		case *ast.StructType:
+			if len(n.Fields.List) == 0 {
+				n.Fields = emptyFieldList()
+				return false
+			}
+		case *ast.InterfaceType:
+			if len(n.Methods.List) == 0 {
+				n.Methods = emptyFieldList()
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// zeroValue returns an expression of a value of the type typ of kind k, that can be used to declare
+// fields of this type, e.g. in field.JSON or GoType.
+func zeroValue(typ ast.Expr, k reflect.Kind) (ast.Expr, error) {
+	switch k {
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return &ast.CompositeLit{Type: typ}, nil
+	case reflect.Ptr:
+		elem := typ.(*ast.StarExpr).X
+		switch elem.(type) {
+		case *ast.StructType, *ast.ArrayType, *ast.MapType:
+			return &ast.UnaryExpr{Op: token.AND, X: &ast.CompositeLit{Type: elem}}, nil
+		}
+		// The kind of named element types is not exposed by RType.
+		return conversion(ast.NewIdent("new"), elem), nil
+	case reflect.String:
+		return conversion(typ, strLit("")), nil
+	case reflect.Bool:
+		return conversion(typ, ast.NewIdent("false")), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return conversion(typ, intLit(0)), nil
+	}
+	return nil, fmt.Errorf("schemast: unsupported Go type of kind %s", k)
+}
+
+// hasGoType reports whether the Go type of a field of a basic type was set using its GoType method.
+func hasGoType(desc *field.Descriptor) bool {
+	switch desc.Info.Type {
+	case field.TypeJSON, field.TypeUUID, field.TypeOther:
+		return false
+	}
+	return desc.Info.RType != nil
+}
+
+// typeExpr returns the expression of the Go type t.
+func typeExpr(t reflect.Type) (ast.Expr, error) {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return ast.NewIdent(t.Name()), nil
+		}
+		// The string representation of a named type is qualified by its package name.
+		pkg := strings.TrimSuffix(t.String(), "."+t.Name())
+		return selectorLit(pkg, t.Name()), nil
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		elem, err := typeExpr(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &ast.StarExpr{X: elem}, nil
+	case reflect.Slice, reflect.Array:
+		elem, err := typeExpr(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		arr := &ast.ArrayType{Elt: elem}
+		if t.Kind() == reflect.Array {
+			arr.Len = intLit(t.Len())
+		}
+		return arr, nil
+	case reflect.Map:
+		key, err := typeExpr(t.Key())
+		if err != nil {
+			return nil, err
+		}
+		val, err := typeExpr(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &ast.MapType{Key: key, Value: val}, nil
+	case reflect.Struct:
+		fields := &ast.FieldList{}
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			typ, err := typeExpr(sf.Type)
+			if err != nil {
+				return nil, err
+			}
+			f := &ast.Field{Type: typ}
+			if !sf.Anonymous {
+				f.Names = []*ast.Ident{ast.NewIdent(sf.Name)}
+			}
+			if sf.Tag != "" {
+				f.Tag = &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(string(sf.Tag))}
+			}
+			fields.List = append(fields.List, f)
+		}
+		if len(fields.List) == 0 {
+			fields = emptyFieldList()
+		}
+		return &ast.StructType{Fields: fields}, nil
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return &ast.InterfaceType{Methods: emptyFieldList()}, nil
+		}
+	}
+	return nil, fmt.Errorf("schemast: unsupported Go type %s", t)
+}
+
+// emptyFieldList returns a field list that is printed on a single line, e.g. in struct{}.
+func emptyFieldList() *ast.FieldList {
+	return &ast.FieldList{Opening: 1, Closing: 1}
+}
+
+// literalExpr returns an expression that evaluates to the value v, e.g. a composite literal holding the
+// non-zero fields of a struct. Values that cannot be expressed as literals, such as structs with non-zero
+// unexported fields, channels or funcs, are reported as errors.
+func literalExpr(v reflect.Value) (ast.Expr, error) {
+	t := v.Type()
+	switch t.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return ast.NewIdent("nil"), nil
+		}
+		if t.Elem().Kind() != reflect.Struct {
+			return nil, fmt.Errorf("schemast: unsupported literal of type %s", t)
+		}
+		elem, err := literalExpr(v.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &ast.UnaryExpr{Op: token.AND, X: elem}, nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return ast.NewIdent("nil"), nil
+		}
+		elem, err := literalExpr(v.Elem())
+		if err != nil {
+			return nil, err
+		}
+		// Basic literals take the default type of their kind when assigned to an interface.
+		if et := v.Elem().Type(); isBasicLit(elem) && !defaultTypes[et] {
+			typ, err := typeExpr(et)
+			if err != nil {
+				return nil, err
+			}
+			return conversion(typ, elem), nil
+		}
+		return elem, nil
+	case reflect.Struct:
+		typ, err := typeExpr(t)
+		if err != nil {
+			return nil, err
+		}
+		lit := &ast.CompositeLit{Type: typ}
+		for i := 0; i < t.NumField(); i++ {
+			sf, fv := t.Field(i), v.Field(i)
+			if fv.IsZero() {
+				continue
+			}
+			if !sf.IsExported() {
+				return nil, fmt.Errorf("schemast: unsupported literal of type %s with unexported field %s", t, sf.Name)
+			}
+			expr, err := literalExpr(fv)
+			if err != nil {
+				return nil, err
+			}
+			lit.Elts = append(lit.Elts, structAttr(sf.Name, expr))
+		}
+		return lit, nil
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && v.IsNil() {
+			return ast.NewIdent("nil"), nil
+		}
+		typ, err := typeExpr(t)
+		if err != nil {
+			return nil, err
+		}
+		lit := &ast.CompositeLit{Type: typ}
+		for i := 0; i < v.Len(); i++ {
+			expr, err := literalExpr(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			lit.Elts = append(lit.Elts, expr)
+		}
+		return lit, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return ast.NewIdent("nil"), nil
+		}
+		typ, err := typeExpr(t)
+		if err != nil {
+			return nil, err
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+		lit := &ast.CompositeLit{Type: typ}
+		for _, k := range keys {
+			key, err := literalExpr(k)
+			if err != nil {
+				return nil, err
+			}
+			val, err := literalExpr(v.MapIndex(k))
+			if err != nil {
+				return nil, err
+			}
+			lit.Elts = append(lit.Elts, &ast.KeyValueExpr{Key: key, Value: val})
+		}
+		return lit, nil
+	case reflect.String:
+		return strLit(v.String()), nil
+	case reflect.Bool:
+		return ast.NewIdent(strconv.FormatBool(v.Bool())), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &ast.BasicLit{Kind: token.INT, Value: strconv.FormatInt(v.Int(), 10)}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &ast.BasicLit{Kind: token.INT, Value: strconv.FormatUint(v.Uint(), 10)}, nil
+	case reflect.Float32, reflect.Float64:
+		f := strconv.FormatFloat(v.Float(), 'g', -1, t.Bits())
+		if !strings.ContainsAny(f, ".eN") {
+			f += ".0"
+		}
+		return &ast.BasicLit{Kind: token.FLOAT, Value: f}, nil
+	}
+	return nil, fmt.Errorf("schemast: unsupported literal of type %s", t)
+}
+
+// defaultTypes holds the default types of untyped constants, which are the types of basic literals
+// that are assigned to interfaces.
+var defaultTypes = map[reflect.Type]bool{
+	reflect.TypeOf(""):         true,
+	reflect.TypeOf(false):      true,
+	reflect.TypeOf(0):          true,
+	reflect.TypeOf(float64(0)): true,
+}
+
+// isBasicLit reports whether expr is a basic literal, or one of the true and false constants.
+func isBasicLit(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.Ident:
+		return e.Name == "true" || e.Name == "false"
+	}
+	return false
+}
+
+// conversion returns the conversion of x to the type typ.
+func conversion(typ, x ast.Expr) ast.Expr {
+	return &ast.CallExpr{Fun: typ, Args: []ast.Expr{x}}
+}
+
+// typeImports returns the import paths of the packages that declare the named types referenced by t.
+func typeImports(t reflect.Type) []string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return nil
+		}
+		return []string{t.PkgPath()}
+	}
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return typeImports(t.Elem())
+	case reflect.Map:
+		return append(typeImports(t.Key()), typeImports(t.Elem())...)
+	case reflect.Struct:
+		var paths []string
+		for i := 0; i < t.NumField(); i++ {
+			paths = append(paths, typeImports(t.Field(i).Type)...)
+		}
+		return paths
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"go/printer"
+	"go/token"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	status     string
+	level      int8
+	customTime time.Time
+)
+
+func TestFieldGoType(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    ent.Field
+		expected string
+	}{
+		{
+			name:     "string",
+			field:    field.String("status").GoType(status("")),
+			expected: `field.String("status").GoType(schemast.status(""))`,
+		},
+		{
+			name:     "int8",
+			field:    field.Int8("level").GoType(level(0)).Optional(),
+			expected: `field.Int8("level").Optional().GoType(schemast.level(0))`,
+		},
+		{
+			name:     "time",
+			field:    field.Time("at").GoType(customTime{}),
+			expected: `field.Time("at").GoType(schemast.customTime{})`,
+		},
+		{
+			name:     "value scanner",
+			field:    field.String("nullable").GoType(sql.NullString{}),
+			expected: `field.String("nullable").GoType(sql.NullString{})`,
+		},
+		{
+			name:     "value scanner pointer",
+			field:    field.String("nullable").GoType(&sql.NullString{}),
+			expected: `field.String("nullable").GoType(new(sql.NullString))`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Field(tt.field.Descriptor())
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+			require.EqualValues(t, tt.expected, buf.String())
+		})
+	}
+}
+
+type priority string
+
+func (priority) Values() []string {
+	return []string{"low", "high"}
+}
+
+func TestFieldEnumGoType(t *testing.T) {
+	desc := field.Enum("priority").GoType(priority("")).Default("low").Descriptor()
+	r, err := Field(desc)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+	require.EqualValues(t, `field.Enum("priority").Default("low").GoType(schemast.priority(""))`, buf.String())
+	require.EqualValues(t, []string{"entgo.io/contrib/schemast"}, fieldImports(desc))
+}
+
+type meta struct {
+	Tags []string
+}
+
+func TestFieldJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    ent.Field
+		expected string
+	}{
+		{
+			name:     "slice",
+			field:    field.JSON("ids", []int64{}),
+			expected: `field.JSON("ids", []int64{})`,
+		},
+		{
+			name:     "strings",
+			field:    field.Strings("tags").Optional(),
+			expected: `field.Strings("tags").Optional()`,
+		},
+		{
+			name:     "ints",
+			field:    field.Ints("counts"),
+			expected: `field.Ints("counts")`,
+		},
+		{
+			name:     "floats",
+			field:    field.JSON("scores", []float64{}),
+			expected: `field.Floats("scores")`,
+		},
+		{
+			name:     "pointer",
+			field:    field.JSON("meta", &meta{}).Optional(),
+			expected: `field.JSON("meta", new(schemast.meta)).Optional()`,
+		},
+		{
+			name:     "map",
+			field:    field.JSON("props", map[string]interface{}{}),
+			expected: `field.JSON("props", map[string]interface{}{})`,
+		},
+		{
+			name:     "struct",
+			field:    field.JSON("meta", meta{}),
+			expected: `field.JSON("meta", schemast.meta{})`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Field(tt.field.Descriptor())
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+			require.EqualValues(t, tt.expected, buf.String())
+		})
+	}
+}
+
+func TestPrintJSON(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.JSON("tags", []string{}).Descriptor()))
+	require.NoError(t, tt.ctx.AppendField("Message", field.JSON("link", &url.URL{}).Optional().Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("message.go")
+	require.Contains(t, contents, `"net/url"`)
+	require.Contains(t, contents, `field.JSON("link", new(url.URL)).Optional()`)
+	fields := tt.getType("Message").Fields
+	require.Len(t, fields, 2)
+	require.EqualValues(t, "[]string", fields[0].Type.String())
+	require.EqualValues(t, "*url.URL", fields[1].Type.String())
+}
+
+// customID is a UUID type that is not declared by the github.com/google/uuid package.
+type customID [16]byte
+
+func (customID) Value() (driver.Value, error) { return nil, nil }
+
+func (*customID) Scan(interface{}) error { return nil }
+
+func TestFieldUUID(t *testing.T) {
+	r, err := Field(field.UUID("id", customID{}).Unique().Descriptor())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+	require.EqualValues(t, `field.UUID("id", schemast.customID{}).Unique()`, buf.String())
+	require.EqualValues(t, []string{"entgo.io/contrib/schemast"}, fieldImports(field.UUID("id", customID{}).Descriptor()))
+	require.EqualValues(t, []string{"github.com/google/uuid"}, fieldImports(field.UUID("id", uuid.UUID{}).Descriptor()))
+}
+
+type point struct {
+	X, Y float64
+}
+
+func (point) Value() (driver.Value, error) { return nil, nil }
+
+func (*point) Scan(interface{}) error { return nil }
+
+func TestFieldOther(t *testing.T) {
+	r, err := Field(field.Other("location", &point{}).SchemaType(map[string]string{
+		dialect.Postgres: "point",
+		dialect.MySQL:    "point",
+	}).Optional().Descriptor())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+	require.EqualValues(t, `field.Other("location", new(schemast.point)).Optional().SchemaType(map[string]string{"mysql": "point", "postgres": "point"})`, buf.String())
+}
+
+func TestPrintOther(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	desc := field.Other("search", &sql.NullString{}).SchemaType(map[string]string{dialect.Postgres: "tsvector"}).Descriptor()
+	require.NoError(t, tt.ctx.AppendField("Message", desc))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	search := tt.getType("Message").Fields[0]
+	require.EqualValues(t, field.TypeOther, search.Type.Type)
+	require.EqualValues(t, "*sql.NullString", search.Type.String())
+	require.EqualValues(t, map[string]string{dialect.Postgres: "tsvector"}, search.Column().SchemaType)
+}
+
+func TestGoTypeExpr(t *testing.T) {
+	tests := []struct {
+		typ      interface{}
+		expected string
+		pkgPath  string
+	}{
+		{typ: status(""), expected: `schemast.status("")`, pkgPath: "entgo.io/contrib/schemast"},
+		{typ: (*string)(nil), expected: `new(string)`},
+		{typ: &sql.NullString{}, expected: `new(sql.NullString)`, pkgPath: "database/sql"},
+		{typ: []*sql.NullInt64{}, expected: `[]*sql.NullInt64{}`, pkgPath: "database/sql"},
+		{typ: map[string][]level{}, expected: `map[string][]schemast.level{}`, pkgPath: "entgo.io/contrib/schemast"},
+		{typ: &[]string{}, expected: `&[]string{}`},
+		{typ: [2]int{}, expected: `[2]int{}`},
+		{typ: map[string]interface{}{}, expected: `map[string]interface{}{}`},
+		{typ: struct{}{}, expected: `struct{}{}`},
+		{typ: struct {
+			Name string `json:"name"`
+		}{}, expected: "struct {\n\tName string \"json:\\\"name\\\"\"\n}{}"},
+	}
+	for _, tt := range tests {
+		desc := field.JSON("x", tt.typ).Descriptor()
+		t.Run(desc.Info.Ident, func(t *testing.T) {
+			expr, pkgPath, err := goTypeExpr(desc.Info)
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), expr))
+			require.EqualValues(t, tt.expected, buf.String())
+			require.EqualValues(t, tt.pkgPath, pkgPath)
+		})
+	}
+	_, err := Field(field.JSON("x", map[level]*sql.NullString{}).Descriptor())
+	require.EqualError(t, err, `schemast: could not resolve the packages of the Go type map[schemast.level]*sql.NullString of field "x"`)
+}
+
+func TestTypeImports(t *testing.T) {
+	require.Empty(t, typeImports(reflect.TypeOf("")))
+	require.EqualValues(t, []string{"database/sql"}, typeImports(reflect.TypeOf([]*sql.NullInt64{})))
+	require.EqualValues(t, []string{"entgo.io/contrib/schemast", "database/sql"}, typeImports(reflect.TypeOf(map[level]*sql.NullString{})))
+}
+
+func TestPrintGoType(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.String("title").GoType(sql.NullString{}).Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("message.go")
+	require.Contains(t, contents, `"database/sql"`)
+	require.Contains(t, contents, `field.String("title").GoType(sql.NullString{})`)
+	title := tt.getType("Message").Fields[0]
+	require.True(t, title.HasGoType())
+	require.EqualValues(t, "sql.NullString", title.Type.String())
+}
+
+func TestImportPaths(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	ctx.ImportPaths = map[string]string{"database/sql": "example.com/compat/sql"}
+	require.NoError(t, ctx.AppendField("WithFields", field.String("title").GoType(sql.NullString{}).Descriptor()))
+	var imports []string
+	for _, imp := range ctx.methodFile("WithFields", "Fields").Imports {
+		imports = append(imports, imp.Path.Value)
+	}
+	require.Contains(t, imports, `"example.com/compat/sql"`)
+	require.NotContains(t, imports, `"database/sql"`)
+}
@@ -0,0 +1,35 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"fmt"
+	"go/parser"
+)
+
+// AppendHook adds a hook to the returned values of the Hooks method of type typeName, adding the method if it
+// does not exist. The hook is the source of a Go expression of type ent.Hook, e.g. "hook.On(AuditHook, ent.OpCreate)".
+// Hooks cannot be serialized from their values, and the packages they reference are imported using AddImport.
+// There is no counterpart for an Interceptors method, as the version of ent this package depends on declares
+// neither ent.Interceptor nor the Interceptors method of schemas.
+func (c *Context) AppendHook(typeName, hook string) error {
+	expr, err := parser.ParseExpr(hook)
+	if err != nil {
+		return fmt.Errorf("schemast: invalid hook expression %q: %w", hook, err)
+	}
+	clearPos(expr)
+	_, err = c.appendReturnItem(kindHook, typeName, expr)
+	return err
+}
@@ -0,0 +1,48 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendHook(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	noop := "func(next ent.Mutator) ent.Mutator { return next }"
+	require.NoError(t, tt.ctx.AppendHook("User", noop))
+	require.NoError(t, tt.ctx.AppendHook("User", noop))
+	require.Error(t, tt.ctx.AppendHook("User", "func(next"))
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{Name: "Message", Hooks: []string{noop}}))
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{Name: "Message", Hooks: []string{noop}}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), "func (User) Hooks() []ent.Hook {")
+	require.EqualValues(t, 2, tt.getType("User").NumHooks())
+	require.EqualValues(t, 1, tt.getType("Message").NumHooks())
+}
+
+func TestUpsertKeepsHooks(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendHook("User", "func(next ent.Mutator) ent.Mutator { return next }"))
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{Name: "User"}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	require.EqualValues(t, 1, tt.getType("User").NumHooks())
+}
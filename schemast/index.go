@@ -15,7 +15,10 @@
 package schemast
 
 import (
+	"fmt"
 	"go/ast"
+	"go/token"
+	"strconv"
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/index"
@@ -25,29 +28,126 @@ import (
 // of the ent Index package that can be used to construct it.
 func Index(desc *index.Descriptor) (*ast.CallExpr, error) {
 	idx := newIndexCall(desc)
+	if len(desc.Edges) > 0 {
+		var edges []ast.Expr
+		for _, e := range desc.Edges {
+			edges = append(edges, strLit(e))
+		}
+		idx.method("Edges", edges...)
+	}
 	if desc.Unique {
 		idx.method("Unique")
 	}
 	if desc.StorageKey != "" {
 		idx.method("StorageKey", strLit(desc.StorageKey))
 	}
-	if len(desc.Edges) > 0 {
-		var edges []ast.Expr
-		for _, e := range desc.Edges {
-			edges = append(edges, strLit(e))
+	if len(desc.Annotations) != 0 {
+		annots, err := toAnnotASTs(desc.Annotations)
+		if err != nil {
+			return nil, err
 		}
-		idx.method("Edges", edges...)
+		idx.annotate(annots...)
 	}
 	return idx.curr, nil
 }
 
 // AppendIndex adds an index to the returned values of the Indexes method of type typeName.
 func (c *Context) AppendIndex(typeName string, idx ent.Index) error {
-	newIdx, err := Index(idx.Descriptor())
+	desc := idx.Descriptor()
+	newIdx, err := Index(desc)
+	if err != nil {
+		return err
+	}
+	if _, err := c.appendReturnItem(kindIndex, typeName, newIdx); err != nil {
+		return err
+	}
+	c.appendImports(c.methodFile(typeName, kindIndex.methodName), annotationImports(desc.Annotations))
+	return nil
+}
+
+// RemoveIndex removes the index that is declared on the same fields and edges as idx from the returned values of
+// the Indexes method of type typeName. The other options of the indexes, such as Unique, are not compared.
+func (c *Context) RemoveIndex(typeName string, idx ent.Index) error {
+	desc := idx.Descriptor()
+	stmt, err := c.returnStmt(typeName, "Indexes")
 	if err != nil {
 		return err
 	}
-	return c.appendReturnItem(kindIndex, typeName, newIdx)
+	if returned, ok := stmt.Results[0].(*ast.CompositeLit); ok {
+		for i, item := range returned.Elts {
+			call, ok := item.(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			fields, edges, ok := indexKey(call)
+			if ok && equalStrings(fields, desc.Fields) && equalStrings(edges, desc.Edges) {
+				returned.Elts = append(returned.Elts[:i], returned.Elts[i+1:]...)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("schemast: could not find index on fields %q and edges %q in type %q", desc.Fields, desc.Edges, typeName)
+}
+
+// hasIndex reports whether the Indexes method of type typeName returns an index on the same fields and edges
+// as idx.
+func (c *Context) hasIndex(typeName string, idx ent.Index) bool {
+	if _, ok := c.lookupMethod(typeName, kindIndex.methodName); !ok {
+		return false
+	}
+	calls, err := c.returnedCalls(typeName, kindIndex.methodName)
+	if err != nil {
+		return false
+	}
+	desc := idx.Descriptor()
+	for _, call := range calls {
+		fields, edges, ok := indexKey(call)
+		if ok && equalStrings(fields, desc.Fields) && equalStrings(edges, desc.Edges) {
+			return true
+		}
+	}
+	return false
+}
+
+// indexKey returns the fields and edges of an index declaration, e.g. ["name"] and ["owner"] for
+// index.Fields("name").Edges("owner").Unique().
+func indexKey(call *ast.CallExpr) (fields, edges []string, ok bool) {
+	for _, b := range builderCalls(call) {
+		var dst *[]string
+		switch methodName(b) {
+		case "Fields":
+			dst = &fields
+		case "Edges":
+			dst = &edges
+		default:
+			continue
+		}
+		for _, arg := range b.Args {
+			lit, ok := arg.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return nil, nil, false
+			}
+			v, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				return nil, nil, false
+			}
+			*dst = append(*dst, v)
+		}
+	}
+	return fields, edges, true
+}
+
+// equalStrings reports whether a and b hold the same strings, in the same order.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 func newIndexCall(desc *index.Descriptor) *builderCall {
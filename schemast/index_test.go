@@ -21,6 +21,10 @@ import (
 	"testing"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/stretchr/testify/require"
 )
@@ -62,6 +66,16 @@ func TestFromIndexDescriptor(t *testing.T) {
 			index:    index.Fields("cat_id").Edges("edge", "other_edge"),
 			expected: `index.Fields("cat_id").Edges("edge", "other_edge")`,
 		},
+		{
+			name:     "unique edge",
+			index:    index.Fields("name").Edges("owner").Unique(),
+			expected: `index.Fields("name").Edges("owner").Unique()`,
+		},
+		{
+			name:     "annotations",
+			index:    index.Fields("title").Annotations(entsql.Prefix(10), entsql.IndexType("FULLTEXT")),
+			expected: `index.Fields("title").Annotations(entsql.Prefix(10), entsql.IndexType("FULLTEXT"))`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -120,3 +134,80 @@ func (WithFields) Indexes() []ent.Index {
 		})
 	}
 }
+
+func TestRemoveIndex(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	for _, idx := range []ent.Index{
+		index.Fields("a", "b").Unique(),
+		index.Fields("a").Edges("owner"),
+		index.Fields("a"),
+	} {
+		require.NoError(t, ctx.AppendIndex("WithFields", idx))
+	}
+	err = ctx.RemoveIndex("WithFields", index.Fields("b", "a"))
+	require.EqualError(t, err, `schemast: could not find index on fields ["b" "a"] and edges [] in type "WithFields"`)
+	require.NoError(t, ctx.RemoveIndex("WithFields", index.Fields("a", "b")))
+	require.NoError(t, ctx.RemoveIndex("WithFields", index.Fields("a")))
+	err = ctx.RemoveIndex("WithoutFields", index.Fields("a"))
+	require.EqualError(t, err, `schemast: could not find method "Indexes" for type "WithoutFields"`)
+
+	var buf bytes.Buffer
+	method, _ := ctx.lookupMethod("WithFields", "Indexes")
+	require.NoError(t, printer.Fprint(&buf, ctx.SchemaPackage.Fset, method))
+	require.EqualValues(t, `// Indexes of the WithFields.
+func (WithFields) Indexes() []ent.Index {
+	return []ent.Index{index.Fields("a").Edges("owner")}
+}`, buf.String())
+}
+
+func TestPrintIndexAnnotations(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendField("Message", field.String("title").Descriptor()))
+	require.NoError(t, tt.ctx.AppendField("Message", field.String("body").Descriptor()))
+	types := map[string]string{dialect.MySQL: "FULLTEXT", dialect.Postgres: "GIN"}
+	require.NoError(t, tt.ctx.AppendIndex("Message", index.Fields("title").Annotations(entsql.Prefix(10), entsql.Desc())))
+	require.NoError(t, tt.ctx.AppendIndex("Message", index.Fields("body").Annotations(entsql.IndexTypes(types))))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("message.go"), `index.Fields("title").Annotations(entsql.Prefix(10), entsql.Desc())`)
+	indexes := tt.getType("Message").Indexes
+	require.Len(t, indexes, 2)
+	title, ok := indexes[0].Annotations["EntSQLIndexes"].(map[string]interface{})
+	require.True(t, ok)
+	require.EqualValues(t, 10, title["Prefix"])
+	require.EqualValues(t, true, title["Desc"])
+	body, ok := indexes[1].Annotations["EntSQLIndexes"].(map[string]interface{})
+	require.True(t, ok)
+	require.EqualValues(t, map[string]interface{}{"mysql": "FULLTEXT", "postgres": "GIN"}, body["Types"])
+}
+
+func TestUpsertEdgeIndex(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{
+		Name:   "Message",
+		Fields: []ent.Field{field.String("title")},
+		Edges: []ent.Edge{
+			WithType(edge.From("owner", placeholder.Type).Ref("messages").Unique(), "User"),
+		},
+		Indexes: []ent.Index{
+			index.Fields("title").Edges("owner").Unique(),
+		},
+	}, &UpsertSchema{
+		Name: "User",
+		Edges: []ent.Edge{
+			WithType(edge.To("messages", placeholder.Type), "Message"),
+		},
+	}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("message.go"), `index.Fields("title").Edges("owner").Unique()`)
+	indexes := tt.getType("Message").Indexes
+	require.Len(t, indexes, 1)
+	require.True(t, indexes[0].Unique)
+	require.EqualValues(t, []string{"title", "user_messages"}, indexes[0].Columns)
+}
@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/baseschema"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BaseSchema is the model entity for the BaseSchema schema.
+type BaseSchema struct {
+	config
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BaseSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case baseschema.FieldID:
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type BaseSchema", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BaseSchema fields.
+func (bs *BaseSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case baseschema.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bs.ID = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this BaseSchema.
+// Note that you need to call BaseSchema.Unwrap() before calling this method if this BaseSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bs *BaseSchema) Update() *BaseSchemaUpdateOne {
+	return (&BaseSchemaClient{config: bs.config}).UpdateOne(bs)
+}
+
+// Unwrap unwraps the BaseSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bs *BaseSchema) Unwrap() *BaseSchema {
+	_tx, ok := bs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BaseSchema is not a transactional entity")
+	}
+	bs.config.driver = _tx.drv
+	return bs
+}
+
+// String implements the fmt.Stringer.
+func (bs *BaseSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("BaseSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v", bs.ID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BaseSchemas is a parsable slice of BaseSchema.
+type BaseSchemas []*BaseSchema
+
+func (bs BaseSchemas) config(cfg config) {
+	for _i := range bs {
+		bs[_i].config = cfg
+	}
+}
@@ -0,0 +1,27 @@
+// Code generated by ent, DO NOT EDIT.
+
+package baseschema
+
+const (
+	// Label holds the string label denoting the baseschema type in the database.
+	Label = "base_schema"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// Table holds the table name of the baseschema in the database.
+	Table = "base_schemas"
+)
+
+// Columns holds all SQL columns for baseschema fields.
+var Columns = []string{
+	FieldID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
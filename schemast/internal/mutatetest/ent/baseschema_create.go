@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/baseschema"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BaseSchemaCreate is the builder for creating a BaseSchema entity.
+type BaseSchemaCreate struct {
+	config
+	mutation *BaseSchemaMutation
+	hooks    []Hook
+}
+
+// Mutation returns the BaseSchemaMutation object of the builder.
+func (bsc *BaseSchemaCreate) Mutation() *BaseSchemaMutation {
+	return bsc.mutation
+}
+
+// Save creates the BaseSchema in the database.
+func (bsc *BaseSchemaCreate) Save(ctx context.Context) (*BaseSchema, error) {
+	var (
+		err  error
+		node *BaseSchema
+	)
+	if len(bsc.hooks) == 0 {
+		if err = bsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = bsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BaseSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bsc.check(); err != nil {
+				return nil, err
+			}
+			bsc.mutation = mutation
+			if node, err = bsc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bsc.hooks) - 1; i >= 0; i-- {
+			if bsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bsc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bsc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BaseSchema)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BaseSchemaMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bsc *BaseSchemaCreate) SaveX(ctx context.Context) *BaseSchema {
+	v, err := bsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bsc *BaseSchemaCreate) Exec(ctx context.Context) error {
+	_, err := bsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsc *BaseSchemaCreate) ExecX(ctx context.Context) {
+	if err := bsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsc *BaseSchemaCreate) check() error {
+	return nil
+}
+
+func (bsc *BaseSchemaCreate) sqlSave(ctx context.Context) (*BaseSchema, error) {
+	_node, _spec := bsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (bsc *BaseSchemaCreate) createSpec() (*BaseSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BaseSchema{config: bsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: baseschema.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: baseschema.FieldID,
+			},
+		}
+	)
+	return _node, _spec
+}
+
+// BaseSchemaCreateBulk is the builder for creating many BaseSchema entities in bulk.
+type BaseSchemaCreateBulk struct {
+	config
+	builders []*BaseSchemaCreate
+}
+
+// Save creates the BaseSchema entities in the database.
+func (bscb *BaseSchemaCreateBulk) Save(ctx context.Context) ([]*BaseSchema, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bscb.builders))
+	nodes := make([]*BaseSchema, len(bscb.builders))
+	mutators := make([]Mutator, len(bscb.builders))
+	for i := range bscb.builders {
+		func(i int, root context.Context) {
+			builder := bscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BaseSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bscb *BaseSchemaCreateBulk) SaveX(ctx context.Context) []*BaseSchema {
+	v, err := bscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bscb *BaseSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := bscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bscb *BaseSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := bscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
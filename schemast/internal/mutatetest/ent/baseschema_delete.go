@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/baseschema"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BaseSchemaDelete is the builder for deleting a BaseSchema entity.
+type BaseSchemaDelete struct {
+	config
+	hooks    []Hook
+	mutation *BaseSchemaMutation
+}
+
+// Where appends a list predicates to the BaseSchemaDelete builder.
+func (bsd *BaseSchemaDelete) Where(ps ...predicate.BaseSchema) *BaseSchemaDelete {
+	bsd.mutation.Where(ps...)
+	return bsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bsd *BaseSchemaDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bsd.hooks) == 0 {
+		affected, err = bsd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BaseSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bsd.mutation = mutation
+			affected, err = bsd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bsd.hooks) - 1; i >= 0; i-- {
+			if bsd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bsd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bsd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsd *BaseSchemaDelete) ExecX(ctx context.Context) int {
+	n, err := bsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bsd *BaseSchemaDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: baseschema.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: baseschema.FieldID,
+			},
+		},
+	}
+	if ps := bsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// BaseSchemaDeleteOne is the builder for deleting a single BaseSchema entity.
+type BaseSchemaDeleteOne struct {
+	bsd *BaseSchemaDelete
+}
+
+// Exec executes the deletion query.
+func (bsdo *BaseSchemaDeleteOne) Exec(ctx context.Context) error {
+	n, err := bsdo.bsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{baseschema.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsdo *BaseSchemaDeleteOne) ExecX(ctx context.Context) {
+	bsdo.bsd.ExecX(ctx)
+}
@@ -0,0 +1,504 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/baseschema"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BaseSchemaQuery is the builder for querying BaseSchema entities.
+type BaseSchemaQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.BaseSchema
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BaseSchemaQuery builder.
+func (bsq *BaseSchemaQuery) Where(ps ...predicate.BaseSchema) *BaseSchemaQuery {
+	bsq.predicates = append(bsq.predicates, ps...)
+	return bsq
+}
+
+// Limit adds a limit step to the query.
+func (bsq *BaseSchemaQuery) Limit(limit int) *BaseSchemaQuery {
+	bsq.limit = &limit
+	return bsq
+}
+
+// Offset adds an offset step to the query.
+func (bsq *BaseSchemaQuery) Offset(offset int) *BaseSchemaQuery {
+	bsq.offset = &offset
+	return bsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bsq *BaseSchemaQuery) Unique(unique bool) *BaseSchemaQuery {
+	bsq.unique = &unique
+	return bsq
+}
+
+// Order adds an order step to the query.
+func (bsq *BaseSchemaQuery) Order(o ...OrderFunc) *BaseSchemaQuery {
+	bsq.order = append(bsq.order, o...)
+	return bsq
+}
+
+// First returns the first BaseSchema entity from the query.
+// Returns a *NotFoundError when no BaseSchema was found.
+func (bsq *BaseSchemaQuery) First(ctx context.Context) (*BaseSchema, error) {
+	nodes, err := bsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{baseschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bsq *BaseSchemaQuery) FirstX(ctx context.Context) *BaseSchema {
+	node, err := bsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BaseSchema ID from the query.
+// Returns a *NotFoundError when no BaseSchema ID was found.
+func (bsq *BaseSchemaQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{baseschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bsq *BaseSchemaQuery) FirstIDX(ctx context.Context) int {
+	id, err := bsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BaseSchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BaseSchema entity is found.
+// Returns a *NotFoundError when no BaseSchema entities are found.
+func (bsq *BaseSchemaQuery) Only(ctx context.Context) (*BaseSchema, error) {
+	nodes, err := bsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{baseschema.Label}
+	default:
+		return nil, &NotSingularError{baseschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bsq *BaseSchemaQuery) OnlyX(ctx context.Context) *BaseSchema {
+	node, err := bsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BaseSchema ID in the query.
+// Returns a *NotSingularError when more than one BaseSchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bsq *BaseSchemaQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{baseschema.Label}
+	default:
+		err = &NotSingularError{baseschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bsq *BaseSchemaQuery) OnlyIDX(ctx context.Context) int {
+	id, err := bsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BaseSchemas.
+func (bsq *BaseSchemaQuery) All(ctx context.Context) ([]*BaseSchema, error) {
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return bsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bsq *BaseSchemaQuery) AllX(ctx context.Context) []*BaseSchema {
+	nodes, err := bsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BaseSchema IDs.
+func (bsq *BaseSchemaQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := bsq.Select(baseschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bsq *BaseSchemaQuery) IDsX(ctx context.Context) []int {
+	ids, err := bsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bsq *BaseSchemaQuery) Count(ctx context.Context) (int, error) {
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return bsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bsq *BaseSchemaQuery) CountX(ctx context.Context) int {
+	count, err := bsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bsq *BaseSchemaQuery) Exist(ctx context.Context) (bool, error) {
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return bsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bsq *BaseSchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := bsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BaseSchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bsq *BaseSchemaQuery) Clone() *BaseSchemaQuery {
+	if bsq == nil {
+		return nil
+	}
+	return &BaseSchemaQuery{
+		config:     bsq.config,
+		limit:      bsq.limit,
+		offset:     bsq.offset,
+		order:      append([]OrderFunc{}, bsq.order...),
+		predicates: append([]predicate.BaseSchema{}, bsq.predicates...),
+		// clone intermediate query.
+		sql:    bsq.sql.Clone(),
+		path:   bsq.path,
+		unique: bsq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+func (bsq *BaseSchemaQuery) GroupBy(field string, fields ...string) *BaseSchemaGroupBy {
+	grbuild := &BaseSchemaGroupBy{config: bsq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bsq.sqlQuery(ctx), nil
+	}
+	grbuild.label = baseschema.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+func (bsq *BaseSchemaQuery) Select(fields ...string) *BaseSchemaSelect {
+	bsq.fields = append(bsq.fields, fields...)
+	selbuild := &BaseSchemaSelect{BaseSchemaQuery: bsq}
+	selbuild.label = baseschema.Label
+	selbuild.flds, selbuild.scan = &bsq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (bsq *BaseSchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range bsq.fields {
+		if !baseschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bsq.path != nil {
+		prev, err := bsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bsq.sql = prev
+	}
+	return nil
+}
+
+func (bsq *BaseSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BaseSchema, error) {
+	var (
+		nodes = []*BaseSchema{}
+		_spec = bsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BaseSchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BaseSchema{config: bsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (bsq *BaseSchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bsq.querySpec()
+	_spec.Node.Columns = bsq.fields
+	if len(bsq.fields) > 0 {
+		_spec.Unique = bsq.unique != nil && *bsq.unique
+	}
+	return sqlgraph.CountNodes(ctx, bsq.driver, _spec)
+}
+
+func (bsq *BaseSchemaQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := bsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (bsq *BaseSchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   baseschema.Table,
+			Columns: baseschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: baseschema.FieldID,
+			},
+		},
+		From:   bsq.sql,
+		Unique: true,
+	}
+	if unique := bsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := bsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, baseschema.FieldID)
+		for i := range fields {
+			if fields[i] != baseschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bsq *BaseSchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bsq.driver.Dialect())
+	t1 := builder.Table(baseschema.Table)
+	columns := bsq.fields
+	if len(columns) == 0 {
+		columns = baseschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bsq.sql != nil {
+		selector = bsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bsq.unique != nil && *bsq.unique {
+		selector.Distinct()
+	}
+	for _, p := range bsq.predicates {
+		p(selector)
+	}
+	for _, p := range bsq.order {
+		p(selector)
+	}
+	if offset := bsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BaseSchemaGroupBy is the group-by builder for BaseSchema entities.
+type BaseSchemaGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bsgb *BaseSchemaGroupBy) Aggregate(fns ...AggregateFunc) *BaseSchemaGroupBy {
+	bsgb.fns = append(bsgb.fns, fns...)
+	return bsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (bsgb *BaseSchemaGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := bsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bsgb.sql = query
+	return bsgb.sqlScan(ctx, v)
+}
+
+func (bsgb *BaseSchemaGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range bsgb.fields {
+		if !baseschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bsgb *BaseSchemaGroupBy) sqlQuery() *sql.Selector {
+	selector := bsgb.sql.Select()
+	aggregation := make([]string, 0, len(bsgb.fns))
+	for _, fn := range bsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(bsgb.fields)+len(bsgb.fns))
+		for _, f := range bsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(bsgb.fields...)...)
+}
+
+// BaseSchemaSelect is the builder for selecting fields of BaseSchema entities.
+type BaseSchemaSelect struct {
+	*BaseSchemaQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bss *BaseSchemaSelect) Scan(ctx context.Context, v any) error {
+	if err := bss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bss.sql = bss.BaseSchemaQuery.sqlQuery(ctx)
+	return bss.sqlScan(ctx, v)
+}
+
+func (bss *BaseSchemaSelect) sqlScan(ctx context.Context, v any) error {
+	rows := &sql.Rows{}
+	query, args := bss.sql.Query()
+	if err := bss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
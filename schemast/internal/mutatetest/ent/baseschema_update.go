@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/baseschema"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BaseSchemaUpdate is the builder for updating BaseSchema entities.
+type BaseSchemaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BaseSchemaMutation
+}
+
+// Where appends a list predicates to the BaseSchemaUpdate builder.
+func (bsu *BaseSchemaUpdate) Where(ps ...predicate.BaseSchema) *BaseSchemaUpdate {
+	bsu.mutation.Where(ps...)
+	return bsu
+}
+
+// Mutation returns the BaseSchemaMutation object of the builder.
+func (bsu *BaseSchemaUpdate) Mutation() *BaseSchemaMutation {
+	return bsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bsu *BaseSchemaUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bsu.hooks) == 0 {
+		affected, err = bsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BaseSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bsu.mutation = mutation
+			affected, err = bsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bsu.hooks) - 1; i >= 0; i-- {
+			if bsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsu *BaseSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := bsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bsu *BaseSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := bsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsu *BaseSchemaUpdate) ExecX(ctx context.Context) {
+	if err := bsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (bsu *BaseSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   baseschema.Table,
+			Columns: baseschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: baseschema.FieldID,
+			},
+		},
+	}
+	if ps := bsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{baseschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BaseSchemaUpdateOne is the builder for updating a single BaseSchema entity.
+type BaseSchemaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BaseSchemaMutation
+}
+
+// Mutation returns the BaseSchemaMutation object of the builder.
+func (bsuo *BaseSchemaUpdateOne) Mutation() *BaseSchemaMutation {
+	return bsuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bsuo *BaseSchemaUpdateOne) Select(field string, fields ...string) *BaseSchemaUpdateOne {
+	bsuo.fields = append([]string{field}, fields...)
+	return bsuo
+}
+
+// Save executes the query and returns the updated BaseSchema entity.
+func (bsuo *BaseSchemaUpdateOne) Save(ctx context.Context) (*BaseSchema, error) {
+	var (
+		err  error
+		node *BaseSchema
+	)
+	if len(bsuo.hooks) == 0 {
+		node, err = bsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BaseSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bsuo.mutation = mutation
+			node, err = bsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bsuo.hooks) - 1; i >= 0; i-- {
+			if bsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bsuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bsuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BaseSchema)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BaseSchemaMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsuo *BaseSchemaUpdateOne) SaveX(ctx context.Context) *BaseSchema {
+	node, err := bsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bsuo *BaseSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := bsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsuo *BaseSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := bsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (bsuo *BaseSchemaUpdateOne) sqlSave(ctx context.Context) (_node *BaseSchema, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   baseschema.Table,
+			Columns: baseschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: baseschema.FieldID,
+			},
+		},
+	}
+	id, ok := bsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BaseSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, baseschema.FieldID)
+		for _, f := range fields {
+			if !baseschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != baseschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &BaseSchema{config: bsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{baseschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -9,12 +9,19 @@ import (
 	"log"
 
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/migrate"
+	"github.com/google/uuid"
 
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/baseschema"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/user"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withbaseschema"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withfields"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withgroups"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withmodifiedfield"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withnilfields"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withoutfields"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withsplitfields"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withuuidid"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withvalidator"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -26,14 +33,26 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// BaseSchema is the client for interacting with the BaseSchema builders.
+	BaseSchema *BaseSchemaClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WithBaseSchema is the client for interacting with the WithBaseSchema builders.
+	WithBaseSchema *WithBaseSchemaClient
 	// WithFields is the client for interacting with the WithFields builders.
 	WithFields *WithFieldsClient
+	// WithGroups is the client for interacting with the WithGroups builders.
+	WithGroups *WithGroupsClient
 	// WithModifiedField is the client for interacting with the WithModifiedField builders.
 	WithModifiedField *WithModifiedFieldClient
 	// WithNilFields is the client for interacting with the WithNilFields builders.
 	WithNilFields *WithNilFieldsClient
+	// WithSplitFields is the client for interacting with the WithSplitFields builders.
+	WithSplitFields *WithSplitFieldsClient
+	// WithUUIDID is the client for interacting with the WithUUIDID builders.
+	WithUUIDID *WithUUIDIDClient
+	// WithValidator is the client for interacting with the WithValidator builders.
+	WithValidator *WithValidatorClient
 	// WithoutFields is the client for interacting with the WithoutFields builders.
 	WithoutFields *WithoutFieldsClient
 }
@@ -49,10 +68,16 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.BaseSchema = NewBaseSchemaClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.WithBaseSchema = NewWithBaseSchemaClient(c.config)
 	c.WithFields = NewWithFieldsClient(c.config)
+	c.WithGroups = NewWithGroupsClient(c.config)
 	c.WithModifiedField = NewWithModifiedFieldClient(c.config)
 	c.WithNilFields = NewWithNilFieldsClient(c.config)
+	c.WithSplitFields = NewWithSplitFieldsClient(c.config)
+	c.WithUUIDID = NewWithUUIDIDClient(c.config)
+	c.WithValidator = NewWithValidatorClient(c.config)
 	c.WithoutFields = NewWithoutFieldsClient(c.config)
 }
 
@@ -87,10 +112,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:               ctx,
 		config:            cfg,
+		BaseSchema:        NewBaseSchemaClient(cfg),
 		User:              NewUserClient(cfg),
+		WithBaseSchema:    NewWithBaseSchemaClient(cfg),
 		WithFields:        NewWithFieldsClient(cfg),
+		WithGroups:        NewWithGroupsClient(cfg),
 		WithModifiedField: NewWithModifiedFieldClient(cfg),
 		WithNilFields:     NewWithNilFieldsClient(cfg),
+		WithSplitFields:   NewWithSplitFieldsClient(cfg),
+		WithUUIDID:        NewWithUUIDIDClient(cfg),
+		WithValidator:     NewWithValidatorClient(cfg),
 		WithoutFields:     NewWithoutFieldsClient(cfg),
 	}, nil
 }
@@ -111,10 +142,16 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:               ctx,
 		config:            cfg,
+		BaseSchema:        NewBaseSchemaClient(cfg),
 		User:              NewUserClient(cfg),
+		WithBaseSchema:    NewWithBaseSchemaClient(cfg),
 		WithFields:        NewWithFieldsClient(cfg),
+		WithGroups:        NewWithGroupsClient(cfg),
 		WithModifiedField: NewWithModifiedFieldClient(cfg),
 		WithNilFields:     NewWithNilFieldsClient(cfg),
+		WithSplitFields:   NewWithSplitFieldsClient(cfg),
+		WithUUIDID:        NewWithUUIDIDClient(cfg),
+		WithValidator:     NewWithValidatorClient(cfg),
 		WithoutFields:     NewWithoutFieldsClient(cfg),
 	}, nil
 }
@@ -122,7 +159,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		User.
+//		BaseSchema.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -144,13 +181,109 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.BaseSchema.Use(hooks...)
 	c.User.Use(hooks...)
+	c.WithBaseSchema.Use(hooks...)
 	c.WithFields.Use(hooks...)
+	c.WithGroups.Use(hooks...)
 	c.WithModifiedField.Use(hooks...)
 	c.WithNilFields.Use(hooks...)
+	c.WithSplitFields.Use(hooks...)
+	c.WithUUIDID.Use(hooks...)
+	c.WithValidator.Use(hooks...)
 	c.WithoutFields.Use(hooks...)
 }
 
+// BaseSchemaClient is a client for the BaseSchema schema.
+type BaseSchemaClient struct {
+	config
+}
+
+// NewBaseSchemaClient returns a client for the BaseSchema from the given config.
+func NewBaseSchemaClient(c config) *BaseSchemaClient {
+	return &BaseSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `baseschema.Hooks(f(g(h())))`.
+func (c *BaseSchemaClient) Use(hooks ...Hook) {
+	c.hooks.BaseSchema = append(c.hooks.BaseSchema, hooks...)
+}
+
+// Create returns a builder for creating a BaseSchema entity.
+func (c *BaseSchemaClient) Create() *BaseSchemaCreate {
+	mutation := newBaseSchemaMutation(c.config, OpCreate)
+	return &BaseSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BaseSchema entities.
+func (c *BaseSchemaClient) CreateBulk(builders ...*BaseSchemaCreate) *BaseSchemaCreateBulk {
+	return &BaseSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BaseSchema.
+func (c *BaseSchemaClient) Update() *BaseSchemaUpdate {
+	mutation := newBaseSchemaMutation(c.config, OpUpdate)
+	return &BaseSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BaseSchemaClient) UpdateOne(bs *BaseSchema) *BaseSchemaUpdateOne {
+	mutation := newBaseSchemaMutation(c.config, OpUpdateOne, withBaseSchema(bs))
+	return &BaseSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BaseSchemaClient) UpdateOneID(id int) *BaseSchemaUpdateOne {
+	mutation := newBaseSchemaMutation(c.config, OpUpdateOne, withBaseSchemaID(id))
+	return &BaseSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BaseSchema.
+func (c *BaseSchemaClient) Delete() *BaseSchemaDelete {
+	mutation := newBaseSchemaMutation(c.config, OpDelete)
+	return &BaseSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BaseSchemaClient) DeleteOne(bs *BaseSchema) *BaseSchemaDeleteOne {
+	return c.DeleteOneID(bs.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *BaseSchemaClient) DeleteOneID(id int) *BaseSchemaDeleteOne {
+	builder := c.Delete().Where(baseschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BaseSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for BaseSchema.
+func (c *BaseSchemaClient) Query() *BaseSchemaQuery {
+	return &BaseSchemaQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a BaseSchema entity by its id.
+func (c *BaseSchemaClient) Get(ctx context.Context, id int) (*BaseSchema, error) {
+	return c.Query().Where(baseschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BaseSchemaClient) GetX(ctx context.Context, id int) *BaseSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BaseSchemaClient) Hooks() []Hook {
+	return c.hooks.BaseSchema
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -241,6 +374,96 @@ func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
 }
 
+// WithBaseSchemaClient is a client for the WithBaseSchema schema.
+type WithBaseSchemaClient struct {
+	config
+}
+
+// NewWithBaseSchemaClient returns a client for the WithBaseSchema from the given config.
+func NewWithBaseSchemaClient(c config) *WithBaseSchemaClient {
+	return &WithBaseSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `withbaseschema.Hooks(f(g(h())))`.
+func (c *WithBaseSchemaClient) Use(hooks ...Hook) {
+	c.hooks.WithBaseSchema = append(c.hooks.WithBaseSchema, hooks...)
+}
+
+// Create returns a builder for creating a WithBaseSchema entity.
+func (c *WithBaseSchemaClient) Create() *WithBaseSchemaCreate {
+	mutation := newWithBaseSchemaMutation(c.config, OpCreate)
+	return &WithBaseSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WithBaseSchema entities.
+func (c *WithBaseSchemaClient) CreateBulk(builders ...*WithBaseSchemaCreate) *WithBaseSchemaCreateBulk {
+	return &WithBaseSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WithBaseSchema.
+func (c *WithBaseSchemaClient) Update() *WithBaseSchemaUpdate {
+	mutation := newWithBaseSchemaMutation(c.config, OpUpdate)
+	return &WithBaseSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WithBaseSchemaClient) UpdateOne(wbs *WithBaseSchema) *WithBaseSchemaUpdateOne {
+	mutation := newWithBaseSchemaMutation(c.config, OpUpdateOne, withWithBaseSchema(wbs))
+	return &WithBaseSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WithBaseSchemaClient) UpdateOneID(id int) *WithBaseSchemaUpdateOne {
+	mutation := newWithBaseSchemaMutation(c.config, OpUpdateOne, withWithBaseSchemaID(id))
+	return &WithBaseSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WithBaseSchema.
+func (c *WithBaseSchemaClient) Delete() *WithBaseSchemaDelete {
+	mutation := newWithBaseSchemaMutation(c.config, OpDelete)
+	return &WithBaseSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WithBaseSchemaClient) DeleteOne(wbs *WithBaseSchema) *WithBaseSchemaDeleteOne {
+	return c.DeleteOneID(wbs.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WithBaseSchemaClient) DeleteOneID(id int) *WithBaseSchemaDeleteOne {
+	builder := c.Delete().Where(withbaseschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WithBaseSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for WithBaseSchema.
+func (c *WithBaseSchemaClient) Query() *WithBaseSchemaQuery {
+	return &WithBaseSchemaQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WithBaseSchema entity by its id.
+func (c *WithBaseSchemaClient) Get(ctx context.Context, id int) (*WithBaseSchema, error) {
+	return c.Query().Where(withbaseschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WithBaseSchemaClient) GetX(ctx context.Context, id int) *WithBaseSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WithBaseSchemaClient) Hooks() []Hook {
+	return c.hooks.WithBaseSchema
+}
+
 // WithFieldsClient is a client for the WithFields schema.
 type WithFieldsClient struct {
 	config
@@ -331,6 +554,96 @@ func (c *WithFieldsClient) Hooks() []Hook {
 	return c.hooks.WithFields
 }
 
+// WithGroupsClient is a client for the WithGroups schema.
+type WithGroupsClient struct {
+	config
+}
+
+// NewWithGroupsClient returns a client for the WithGroups from the given config.
+func NewWithGroupsClient(c config) *WithGroupsClient {
+	return &WithGroupsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `withgroups.Hooks(f(g(h())))`.
+func (c *WithGroupsClient) Use(hooks ...Hook) {
+	c.hooks.WithGroups = append(c.hooks.WithGroups, hooks...)
+}
+
+// Create returns a builder for creating a WithGroups entity.
+func (c *WithGroupsClient) Create() *WithGroupsCreate {
+	mutation := newWithGroupsMutation(c.config, OpCreate)
+	return &WithGroupsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WithGroups entities.
+func (c *WithGroupsClient) CreateBulk(builders ...*WithGroupsCreate) *WithGroupsCreateBulk {
+	return &WithGroupsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WithGroups.
+func (c *WithGroupsClient) Update() *WithGroupsUpdate {
+	mutation := newWithGroupsMutation(c.config, OpUpdate)
+	return &WithGroupsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WithGroupsClient) UpdateOne(wg *WithGroups) *WithGroupsUpdateOne {
+	mutation := newWithGroupsMutation(c.config, OpUpdateOne, withWithGroups(wg))
+	return &WithGroupsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WithGroupsClient) UpdateOneID(id int) *WithGroupsUpdateOne {
+	mutation := newWithGroupsMutation(c.config, OpUpdateOne, withWithGroupsID(id))
+	return &WithGroupsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WithGroups.
+func (c *WithGroupsClient) Delete() *WithGroupsDelete {
+	mutation := newWithGroupsMutation(c.config, OpDelete)
+	return &WithGroupsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WithGroupsClient) DeleteOne(wg *WithGroups) *WithGroupsDeleteOne {
+	return c.DeleteOneID(wg.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WithGroupsClient) DeleteOneID(id int) *WithGroupsDeleteOne {
+	builder := c.Delete().Where(withgroups.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WithGroupsDeleteOne{builder}
+}
+
+// Query returns a query builder for WithGroups.
+func (c *WithGroupsClient) Query() *WithGroupsQuery {
+	return &WithGroupsQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WithGroups entity by its id.
+func (c *WithGroupsClient) Get(ctx context.Context, id int) (*WithGroups, error) {
+	return c.Query().Where(withgroups.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WithGroupsClient) GetX(ctx context.Context, id int) *WithGroups {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WithGroupsClient) Hooks() []Hook {
+	return c.hooks.WithGroups
+}
+
 // WithModifiedFieldClient is a client for the WithModifiedField schema.
 type WithModifiedFieldClient struct {
 	config
@@ -527,6 +840,276 @@ func (c *WithNilFieldsClient) Hooks() []Hook {
 	return c.hooks.WithNilFields
 }
 
+// WithSplitFieldsClient is a client for the WithSplitFields schema.
+type WithSplitFieldsClient struct {
+	config
+}
+
+// NewWithSplitFieldsClient returns a client for the WithSplitFields from the given config.
+func NewWithSplitFieldsClient(c config) *WithSplitFieldsClient {
+	return &WithSplitFieldsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `withsplitfields.Hooks(f(g(h())))`.
+func (c *WithSplitFieldsClient) Use(hooks ...Hook) {
+	c.hooks.WithSplitFields = append(c.hooks.WithSplitFields, hooks...)
+}
+
+// Create returns a builder for creating a WithSplitFields entity.
+func (c *WithSplitFieldsClient) Create() *WithSplitFieldsCreate {
+	mutation := newWithSplitFieldsMutation(c.config, OpCreate)
+	return &WithSplitFieldsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WithSplitFields entities.
+func (c *WithSplitFieldsClient) CreateBulk(builders ...*WithSplitFieldsCreate) *WithSplitFieldsCreateBulk {
+	return &WithSplitFieldsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WithSplitFields.
+func (c *WithSplitFieldsClient) Update() *WithSplitFieldsUpdate {
+	mutation := newWithSplitFieldsMutation(c.config, OpUpdate)
+	return &WithSplitFieldsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WithSplitFieldsClient) UpdateOne(wsf *WithSplitFields) *WithSplitFieldsUpdateOne {
+	mutation := newWithSplitFieldsMutation(c.config, OpUpdateOne, withWithSplitFields(wsf))
+	return &WithSplitFieldsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WithSplitFieldsClient) UpdateOneID(id int) *WithSplitFieldsUpdateOne {
+	mutation := newWithSplitFieldsMutation(c.config, OpUpdateOne, withWithSplitFieldsID(id))
+	return &WithSplitFieldsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WithSplitFields.
+func (c *WithSplitFieldsClient) Delete() *WithSplitFieldsDelete {
+	mutation := newWithSplitFieldsMutation(c.config, OpDelete)
+	return &WithSplitFieldsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WithSplitFieldsClient) DeleteOne(wsf *WithSplitFields) *WithSplitFieldsDeleteOne {
+	return c.DeleteOneID(wsf.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WithSplitFieldsClient) DeleteOneID(id int) *WithSplitFieldsDeleteOne {
+	builder := c.Delete().Where(withsplitfields.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WithSplitFieldsDeleteOne{builder}
+}
+
+// Query returns a query builder for WithSplitFields.
+func (c *WithSplitFieldsClient) Query() *WithSplitFieldsQuery {
+	return &WithSplitFieldsQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WithSplitFields entity by its id.
+func (c *WithSplitFieldsClient) Get(ctx context.Context, id int) (*WithSplitFields, error) {
+	return c.Query().Where(withsplitfields.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WithSplitFieldsClient) GetX(ctx context.Context, id int) *WithSplitFields {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WithSplitFieldsClient) Hooks() []Hook {
+	return c.hooks.WithSplitFields
+}
+
+// WithUUIDIDClient is a client for the WithUUIDID schema.
+type WithUUIDIDClient struct {
+	config
+}
+
+// NewWithUUIDIDClient returns a client for the WithUUIDID from the given config.
+func NewWithUUIDIDClient(c config) *WithUUIDIDClient {
+	return &WithUUIDIDClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `withuuidid.Hooks(f(g(h())))`.
+func (c *WithUUIDIDClient) Use(hooks ...Hook) {
+	c.hooks.WithUUIDID = append(c.hooks.WithUUIDID, hooks...)
+}
+
+// Create returns a builder for creating a WithUUIDID entity.
+func (c *WithUUIDIDClient) Create() *WithUUIDIDCreate {
+	mutation := newWithUUIDIDMutation(c.config, OpCreate)
+	return &WithUUIDIDCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WithUUIDID entities.
+func (c *WithUUIDIDClient) CreateBulk(builders ...*WithUUIDIDCreate) *WithUUIDIDCreateBulk {
+	return &WithUUIDIDCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WithUUIDID.
+func (c *WithUUIDIDClient) Update() *WithUUIDIDUpdate {
+	mutation := newWithUUIDIDMutation(c.config, OpUpdate)
+	return &WithUUIDIDUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WithUUIDIDClient) UpdateOne(wu *WithUUIDID) *WithUUIDIDUpdateOne {
+	mutation := newWithUUIDIDMutation(c.config, OpUpdateOne, withWithUUIDID(wu))
+	return &WithUUIDIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WithUUIDIDClient) UpdateOneID(id uuid.UUID) *WithUUIDIDUpdateOne {
+	mutation := newWithUUIDIDMutation(c.config, OpUpdateOne, withWithUUIDIDID(id))
+	return &WithUUIDIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WithUUIDID.
+func (c *WithUUIDIDClient) Delete() *WithUUIDIDDelete {
+	mutation := newWithUUIDIDMutation(c.config, OpDelete)
+	return &WithUUIDIDDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WithUUIDIDClient) DeleteOne(wu *WithUUIDID) *WithUUIDIDDeleteOne {
+	return c.DeleteOneID(wu.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WithUUIDIDClient) DeleteOneID(id uuid.UUID) *WithUUIDIDDeleteOne {
+	builder := c.Delete().Where(withuuidid.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WithUUIDIDDeleteOne{builder}
+}
+
+// Query returns a query builder for WithUUIDID.
+func (c *WithUUIDIDClient) Query() *WithUUIDIDQuery {
+	return &WithUUIDIDQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WithUUIDID entity by its id.
+func (c *WithUUIDIDClient) Get(ctx context.Context, id uuid.UUID) (*WithUUIDID, error) {
+	return c.Query().Where(withuuidid.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WithUUIDIDClient) GetX(ctx context.Context, id uuid.UUID) *WithUUIDID {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WithUUIDIDClient) Hooks() []Hook {
+	return c.hooks.WithUUIDID
+}
+
+// WithValidatorClient is a client for the WithValidator schema.
+type WithValidatorClient struct {
+	config
+}
+
+// NewWithValidatorClient returns a client for the WithValidator from the given config.
+func NewWithValidatorClient(c config) *WithValidatorClient {
+	return &WithValidatorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `withvalidator.Hooks(f(g(h())))`.
+func (c *WithValidatorClient) Use(hooks ...Hook) {
+	c.hooks.WithValidator = append(c.hooks.WithValidator, hooks...)
+}
+
+// Create returns a builder for creating a WithValidator entity.
+func (c *WithValidatorClient) Create() *WithValidatorCreate {
+	mutation := newWithValidatorMutation(c.config, OpCreate)
+	return &WithValidatorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WithValidator entities.
+func (c *WithValidatorClient) CreateBulk(builders ...*WithValidatorCreate) *WithValidatorCreateBulk {
+	return &WithValidatorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WithValidator.
+func (c *WithValidatorClient) Update() *WithValidatorUpdate {
+	mutation := newWithValidatorMutation(c.config, OpUpdate)
+	return &WithValidatorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WithValidatorClient) UpdateOne(wv *WithValidator) *WithValidatorUpdateOne {
+	mutation := newWithValidatorMutation(c.config, OpUpdateOne, withWithValidator(wv))
+	return &WithValidatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WithValidatorClient) UpdateOneID(id int) *WithValidatorUpdateOne {
+	mutation := newWithValidatorMutation(c.config, OpUpdateOne, withWithValidatorID(id))
+	return &WithValidatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WithValidator.
+func (c *WithValidatorClient) Delete() *WithValidatorDelete {
+	mutation := newWithValidatorMutation(c.config, OpDelete)
+	return &WithValidatorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WithValidatorClient) DeleteOne(wv *WithValidator) *WithValidatorDeleteOne {
+	return c.DeleteOneID(wv.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WithValidatorClient) DeleteOneID(id int) *WithValidatorDeleteOne {
+	builder := c.Delete().Where(withvalidator.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WithValidatorDeleteOne{builder}
+}
+
+// Query returns a query builder for WithValidator.
+func (c *WithValidatorClient) Query() *WithValidatorQuery {
+	return &WithValidatorQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WithValidator entity by its id.
+func (c *WithValidatorClient) Get(ctx context.Context, id int) (*WithValidator, error) {
+	return c.Query().Where(withvalidator.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WithValidatorClient) GetX(ctx context.Context, id int) *WithValidator {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WithValidatorClient) Hooks() []Hook {
+	return c.hooks.WithValidator
+}
+
 // WithoutFieldsClient is a client for the WithoutFields schema.
 type WithoutFieldsClient struct {
 	config
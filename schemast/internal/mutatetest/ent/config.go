@@ -24,10 +24,16 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
+	BaseSchema        []ent.Hook
 	User              []ent.Hook
+	WithBaseSchema    []ent.Hook
 	WithFields        []ent.Hook
+	WithGroups        []ent.Hook
 	WithModifiedField []ent.Hook
 	WithNilFields     []ent.Hook
+	WithSplitFields   []ent.Hook
+	WithUUIDID        []ent.Hook
+	WithValidator     []ent.Hook
 	WithoutFields     []ent.Hook
 }
 
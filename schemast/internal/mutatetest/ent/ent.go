@@ -7,11 +7,17 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/baseschema"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/user"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withbaseschema"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withfields"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withgroups"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withmodifiedfield"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withnilfields"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withoutfields"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withsplitfields"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withuuidid"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withvalidator"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -35,10 +41,16 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
+		baseschema.Table:        baseschema.ValidColumn,
 		user.Table:              user.ValidColumn,
+		withbaseschema.Table:    withbaseschema.ValidColumn,
 		withfields.Table:        withfields.ValidColumn,
+		withgroups.Table:        withgroups.ValidColumn,
 		withmodifiedfield.Table: withmodifiedfield.ValidColumn,
 		withnilfields.Table:     withnilfields.ValidColumn,
+		withsplitfields.Table:   withsplitfields.ValidColumn,
+		withuuidid.Table:        withuuidid.ValidColumn,
+		withvalidator.Table:     withvalidator.ValidColumn,
 		withoutfields.Table:     withoutfields.ValidColumn,
 	}
 	check, ok := checks[table]
@@ -9,6 +9,19 @@ import (
 	"entgo.io/contrib/schemast/internal/mutatetest/ent"
 )
 
+// The BaseSchemaFunc type is an adapter to allow the use of ordinary
+// function as BaseSchema mutator.
+type BaseSchemaFunc func(context.Context, *ent.BaseSchemaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BaseSchemaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.BaseSchemaMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BaseSchemaMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -22,6 +35,19 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return f(ctx, mv)
 }
 
+// The WithBaseSchemaFunc type is an adapter to allow the use of ordinary
+// function as WithBaseSchema mutator.
+type WithBaseSchemaFunc func(context.Context, *ent.WithBaseSchemaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WithBaseSchemaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WithBaseSchemaMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WithBaseSchemaMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The WithFieldsFunc type is an adapter to allow the use of ordinary
 // function as WithFields mutator.
 type WithFieldsFunc func(context.Context, *ent.WithFieldsMutation) (ent.Value, error)
@@ -35,6 +61,19 @@ func (f WithFieldsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return f(ctx, mv)
 }
 
+// The WithGroupsFunc type is an adapter to allow the use of ordinary
+// function as WithGroups mutator.
+type WithGroupsFunc func(context.Context, *ent.WithGroupsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WithGroupsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WithGroupsMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WithGroupsMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The WithModifiedFieldFunc type is an adapter to allow the use of ordinary
 // function as WithModifiedField mutator.
 type WithModifiedFieldFunc func(context.Context, *ent.WithModifiedFieldMutation) (ent.Value, error)
@@ -61,6 +100,45 @@ func (f WithNilFieldsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return f(ctx, mv)
 }
 
+// The WithSplitFieldsFunc type is an adapter to allow the use of ordinary
+// function as WithSplitFields mutator.
+type WithSplitFieldsFunc func(context.Context, *ent.WithSplitFieldsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WithSplitFieldsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WithSplitFieldsMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WithSplitFieldsMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WithUUIDIDFunc type is an adapter to allow the use of ordinary
+// function as WithUUIDID mutator.
+type WithUUIDIDFunc func(context.Context, *ent.WithUUIDIDMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WithUUIDIDFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WithUUIDIDMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WithUUIDIDMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WithValidatorFunc type is an adapter to allow the use of ordinary
+// function as WithValidator mutator.
+type WithValidatorFunc func(context.Context, *ent.WithValidatorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WithValidatorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WithValidatorMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WithValidatorMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The WithoutFieldsFunc type is an adapter to allow the use of ordinary
 // function as WithoutFields mutator.
 type WithoutFieldsFunc func(context.Context, *ent.WithoutFieldsMutation) (ent.Value, error)
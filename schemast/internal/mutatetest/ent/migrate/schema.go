@@ -8,6 +8,16 @@ import (
 )
 
 var (
+	// BaseSchemasColumns holds the columns for the "base_schemas" table.
+	BaseSchemasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+	}
+	// BaseSchemasTable holds the schema information for the "base_schemas" table.
+	BaseSchemasTable = &schema.Table{
+		Name:       "base_schemas",
+		Columns:    BaseSchemasColumns,
+		PrimaryKey: []*schema.Column{BaseSchemasColumns[0]},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -18,6 +28,17 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WithBaseSchemasColumns holds the columns for the "with_base_schemas" table.
+	WithBaseSchemasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+	}
+	// WithBaseSchemasTable holds the schema information for the "with_base_schemas" table.
+	WithBaseSchemasTable = &schema.Table{
+		Name:       "with_base_schemas",
+		Columns:    WithBaseSchemasColumns,
+		PrimaryKey: []*schema.Column{WithBaseSchemasColumns[0]},
+	}
 	// WithFieldsColumns holds the columns for the "with_fields" table.
 	WithFieldsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -29,6 +50,18 @@ var (
 		Columns:    WithFieldsColumns,
 		PrimaryKey: []*schema.Column{WithFieldsColumns[0]},
 	}
+	// WithGroupsColumns holds the columns for the "with_groups" table.
+	WithGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WithGroupsTable holds the schema information for the "with_groups" table.
+	WithGroupsTable = &schema.Table{
+		Name:       "with_groups",
+		Columns:    WithGroupsColumns,
+		PrimaryKey: []*schema.Column{WithGroupsColumns[0]},
+	}
 	// WithModifiedFieldsColumns holds the columns for the "with_modified_fields" table.
 	WithModifiedFieldsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -59,6 +92,40 @@ var (
 		Columns:    WithNilFieldsColumns,
 		PrimaryKey: []*schema.Column{WithNilFieldsColumns[0]},
 	}
+	// WithSplitFieldsColumns holds the columns for the "with_split_fields" table.
+	WithSplitFieldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "existing", Type: field.TypeString},
+	}
+	// WithSplitFieldsTable holds the schema information for the "with_split_fields" table.
+	WithSplitFieldsTable = &schema.Table{
+		Name:       "with_split_fields",
+		Columns:    WithSplitFieldsColumns,
+		PrimaryKey: []*schema.Column{WithSplitFieldsColumns[0]},
+	}
+	// WithUuidiDsColumns holds the columns for the "with_uuidi_ds" table.
+	WithUuidiDsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+	}
+	// WithUuidiDsTable holds the schema information for the "with_uuidi_ds" table.
+	WithUuidiDsTable = &schema.Table{
+		Name:       "with_uuidi_ds",
+		Columns:    WithUuidiDsColumns,
+		PrimaryKey: []*schema.Column{WithUuidiDsColumns[0]},
+	}
+	// WithValidatorsColumns holds the columns for the "with_validators" table.
+	WithValidatorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString},
+	}
+	// WithValidatorsTable holds the schema information for the "with_validators" table.
+	WithValidatorsTable = &schema.Table{
+		Name:       "with_validators",
+		Columns:    WithValidatorsColumns,
+		PrimaryKey: []*schema.Column{WithValidatorsColumns[0]},
+	}
 	// WithoutFieldsColumns holds the columns for the "without_fields" table.
 	WithoutFieldsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -71,10 +138,16 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BaseSchemasTable,
 		UsersTable,
+		WithBaseSchemasTable,
 		WithFieldsTable,
+		WithGroupsTable,
 		WithModifiedFieldsTable,
 		WithNilFieldsTable,
+		WithSplitFieldsTable,
+		WithUuidiDsTable,
+		WithValidatorsTable,
 		WithoutFieldsTable,
 	}
 )
@@ -7,10 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withbaseschema"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withfields"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withgroups"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withmodifiedfield"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withsplitfields"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withuuidid"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withvalidator"
+	"github.com/google/uuid"
 
 	"entgo.io/ent"
 )
@@ -24,36 +31,42 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeBaseSchema        = "BaseSchema"
 	TypeUser              = "User"
+	TypeWithBaseSchema    = "WithBaseSchema"
 	TypeWithFields        = "WithFields"
+	TypeWithGroups        = "WithGroups"
 	TypeWithModifiedField = "WithModifiedField"
 	TypeWithNilFields     = "WithNilFields"
+	TypeWithSplitFields   = "WithSplitFields"
+	TypeWithUUIDID        = "WithUUIDID"
+	TypeWithValidator     = "WithValidator"
 	TypeWithoutFields     = "WithoutFields"
 )
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// BaseSchemaMutation represents an operation that mutates the BaseSchema nodes in the graph.
+type BaseSchemaMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	oldValue      func(context.Context) (*BaseSchema, error)
+	predicates    []predicate.BaseSchema
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*BaseSchemaMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// baseschemaOption allows management of the mutation configuration using functional options.
+type baseschemaOption func(*BaseSchemaMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newBaseSchemaMutation creates new mutation for the BaseSchema entity.
+func newBaseSchemaMutation(c config, op Op, opts ...baseschemaOption) *BaseSchemaMutation {
+	m := &BaseSchemaMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeBaseSchema,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -62,20 +75,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withBaseSchemaID sets the ID field of the mutation.
+func withBaseSchemaID(id int) baseschemaOption {
+	return func(m *BaseSchemaMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *BaseSchema
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*BaseSchema, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().BaseSchema.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -84,10 +97,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withBaseSchema sets the old BaseSchema of the mutation.
+func withBaseSchema(node *BaseSchema) baseschemaOption {
+	return func(m *BaseSchemaMutation) {
+		m.oldValue = func(context.Context) (*BaseSchema, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -96,7 +109,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m BaseSchemaMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -104,7 +117,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m BaseSchemaMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -115,7 +128,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *BaseSchemaMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -126,7 +139,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BaseSchemaMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -135,31 +148,31 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BaseSchema.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the BaseSchemaMutation builder.
+func (m *BaseSchemaMutation) Where(ps ...predicate.BaseSchema) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *BaseSchemaMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (BaseSchema).
+func (m *BaseSchemaMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
+func (m *BaseSchemaMutation) Fields() []string {
 	fields := make([]string, 0, 0)
 	return fields
 }
@@ -167,143 +180,142 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *BaseSchemaMutation) Field(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown User field %s", name)
+func (m *BaseSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown BaseSchema field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *BaseSchemaMutation) SetField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown BaseSchema field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *BaseSchemaMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BaseSchemaMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown User numeric field %s", name)
+func (m *BaseSchemaMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown BaseSchema numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *BaseSchemaMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *BaseSchemaMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *BaseSchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BaseSchema nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown User field %s", name)
+func (m *BaseSchemaMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown BaseSchema field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
+func (m *BaseSchemaMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *BaseSchemaMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
+func (m *BaseSchemaMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *BaseSchemaMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
+func (m *BaseSchemaMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *BaseSchemaMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown User unique edge %s", name)
+func (m *BaseSchemaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BaseSchema unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown User edge %s", name)
+func (m *BaseSchemaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BaseSchema edge %s", name)
 }
 
-// WithFieldsMutation represents an operation that mutates the WithFields nodes in the graph.
-type WithFieldsMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	existing      *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*WithFields, error)
-	predicates    []predicate.WithFields
+	oldValue      func(context.Context) (*User, error)
+	predicates    []predicate.User
 }
 
-var _ ent.Mutation = (*WithFieldsMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// withfieldsOption allows management of the mutation configuration using functional options.
-type withfieldsOption func(*WithFieldsMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newWithFieldsMutation creates new mutation for the WithFields entity.
-func newWithFieldsMutation(c config, op Op, opts ...withfieldsOption) *WithFieldsMutation {
-	m := &WithFieldsMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWithFields,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -312,20 +324,20 @@ func newWithFieldsMutation(c config, op Op, opts ...withfieldsOption) *WithField
 	return m
 }
 
-// withWithFieldsID sets the ID field of the mutation.
-func withWithFieldsID(id int) withfieldsOption {
-	return func(m *WithFieldsMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *WithFields
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*WithFields, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().WithFields.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -334,10 +346,10 @@ func withWithFieldsID(id int) withfieldsOption {
 	}
 }
 
-// withWithFields sets the old WithFields of the mutation.
-func withWithFields(node *WithFields) withfieldsOption {
-	return func(m *WithFieldsMutation) {
-		m.oldValue = func(context.Context) (*WithFields, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -346,7 +358,7 @@ func withWithFields(node *WithFields) withfieldsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WithFieldsMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -354,7 +366,7 @@ func (m WithFieldsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WithFieldsMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -365,7 +377,7 @@ func (m WithFieldsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *WithFieldsMutation) ID() (id int, exists bool) {
+func (m *UserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -376,7 +388,7 @@ func (m *WithFieldsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *WithFieldsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -385,238 +397,175 @@ func (m *WithFieldsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().WithFields.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetExisting sets the "existing" field.
-func (m *WithFieldsMutation) SetExisting(s string) {
-	m.existing = &s
-}
-
-// Existing returns the value of the "existing" field in the mutation.
-func (m *WithFieldsMutation) Existing() (r string, exists bool) {
-	v := m.existing
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldExisting returns the old "existing" field's value of the WithFields entity.
-// If the WithFields object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WithFieldsMutation) OldExisting(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExisting is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExisting requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExisting: %w", err)
-	}
-	return oldValue.Existing, nil
-}
-
-// ResetExisting resets all changes to the "existing" field.
-func (m *WithFieldsMutation) ResetExisting() {
-	m.existing = nil
-}
-
-// Where appends a list predicates to the WithFieldsMutation builder.
-func (m *WithFieldsMutation) Where(ps ...predicate.WithFields) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *WithFieldsMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (WithFields).
-func (m *WithFieldsMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WithFieldsMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.existing != nil {
-		fields = append(fields, withfields.FieldExisting)
-	}
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 0)
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WithFieldsMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case withfields.FieldExisting:
-		return m.Existing()
-	}
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WithFieldsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case withfields.FieldExisting:
-		return m.OldExisting(ctx)
-	}
-	return nil, fmt.Errorf("unknown WithFields field %s", name)
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WithFieldsMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case withfields.FieldExisting:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExisting(v)
-		return nil
 	}
-	return fmt.Errorf("unknown WithFields field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WithFieldsMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WithFieldsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WithFieldsMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown WithFields numeric field %s", name)
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WithFieldsMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WithFieldsMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WithFieldsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown WithFields nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WithFieldsMutation) ResetField(name string) error {
-	switch name {
-	case withfields.FieldExisting:
-		m.ResetExisting()
-		return nil
-	}
-	return fmt.Errorf("unknown WithFields field %s", name)
+func (m *UserMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WithFieldsMutation) AddedEdges() []string {
+func (m *UserMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WithFieldsMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WithFieldsMutation) RemovedEdges() []string {
+func (m *UserMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WithFieldsMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WithFieldsMutation) ClearedEdges() []string {
+func (m *UserMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WithFieldsMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WithFieldsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown WithFields unique edge %s", name)
+func (m *UserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WithFieldsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown WithFields edge %s", name)
+func (m *UserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// WithModifiedFieldMutation represents an operation that mutates the WithModifiedField nodes in the graph.
-type WithModifiedFieldMutation struct {
+// WithBaseSchemaMutation represents an operation that mutates the WithBaseSchema nodes in the graph.
+type WithBaseSchemaMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
 	name          *string
 	clearedFields map[string]struct{}
-	owner         *int
-	clearedowner  bool
 	done          bool
-	oldValue      func(context.Context) (*WithModifiedField, error)
-	predicates    []predicate.WithModifiedField
+	oldValue      func(context.Context) (*WithBaseSchema, error)
+	predicates    []predicate.WithBaseSchema
 }
 
-var _ ent.Mutation = (*WithModifiedFieldMutation)(nil)
+var _ ent.Mutation = (*WithBaseSchemaMutation)(nil)
 
-// withmodifiedfieldOption allows management of the mutation configuration using functional options.
-type withmodifiedfieldOption func(*WithModifiedFieldMutation)
+// withbaseschemaOption allows management of the mutation configuration using functional options.
+type withbaseschemaOption func(*WithBaseSchemaMutation)
 
-// newWithModifiedFieldMutation creates new mutation for the WithModifiedField entity.
-func newWithModifiedFieldMutation(c config, op Op, opts ...withmodifiedfieldOption) *WithModifiedFieldMutation {
-	m := &WithModifiedFieldMutation{
+// newWithBaseSchemaMutation creates new mutation for the WithBaseSchema entity.
+func newWithBaseSchemaMutation(c config, op Op, opts ...withbaseschemaOption) *WithBaseSchemaMutation {
+	m := &WithBaseSchemaMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWithModifiedField,
+		typ:           TypeWithBaseSchema,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -625,20 +574,20 @@ func newWithModifiedFieldMutation(c config, op Op, opts ...withmodifiedfieldOpti
 	return m
 }
 
-// withWithModifiedFieldID sets the ID field of the mutation.
-func withWithModifiedFieldID(id int) withmodifiedfieldOption {
-	return func(m *WithModifiedFieldMutation) {
+// withWithBaseSchemaID sets the ID field of the mutation.
+func withWithBaseSchemaID(id int) withbaseschemaOption {
+	return func(m *WithBaseSchemaMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *WithModifiedField
+			value *WithBaseSchema
 		)
-		m.oldValue = func(ctx context.Context) (*WithModifiedField, error) {
+		m.oldValue = func(ctx context.Context) (*WithBaseSchema, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().WithModifiedField.Get(ctx, id)
+					value, err = m.Client().WithBaseSchema.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -647,8 +596,997 @@ func withWithModifiedFieldID(id int) withmodifiedfieldOption {
 	}
 }
 
-// withWithModifiedField sets the old WithModifiedField of the mutation.
-func withWithModifiedField(node *WithModifiedField) withmodifiedfieldOption {
+// withWithBaseSchema sets the old WithBaseSchema of the mutation.
+func withWithBaseSchema(node *WithBaseSchema) withbaseschemaOption {
+	return func(m *WithBaseSchemaMutation) {
+		m.oldValue = func(context.Context) (*WithBaseSchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WithBaseSchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WithBaseSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WithBaseSchemaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WithBaseSchemaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WithBaseSchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *WithBaseSchemaMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WithBaseSchemaMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the WithBaseSchema entity.
+// If the WithBaseSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithBaseSchemaMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WithBaseSchemaMutation) ResetName() {
+	m.name = nil
+}
+
+// Where appends a list predicates to the WithBaseSchemaMutation builder.
+func (m *WithBaseSchemaMutation) Where(ps ...predicate.WithBaseSchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WithBaseSchemaMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WithBaseSchema).
+func (m *WithBaseSchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WithBaseSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, withbaseschema.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WithBaseSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case withbaseschema.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WithBaseSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case withbaseschema.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown WithBaseSchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithBaseSchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case withbaseschema.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WithBaseSchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WithBaseSchemaMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WithBaseSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithBaseSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WithBaseSchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WithBaseSchemaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WithBaseSchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WithBaseSchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WithBaseSchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WithBaseSchemaMutation) ResetField(name string) error {
+	switch name {
+	case withbaseschema.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown WithBaseSchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WithBaseSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WithBaseSchemaMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WithBaseSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WithBaseSchemaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WithBaseSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WithBaseSchemaMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WithBaseSchemaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WithBaseSchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WithBaseSchemaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WithBaseSchema edge %s", name)
+}
+
+// WithFieldsMutation represents an operation that mutates the WithFields nodes in the graph.
+type WithFieldsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	existing      *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WithFields, error)
+	predicates    []predicate.WithFields
+}
+
+var _ ent.Mutation = (*WithFieldsMutation)(nil)
+
+// withfieldsOption allows management of the mutation configuration using functional options.
+type withfieldsOption func(*WithFieldsMutation)
+
+// newWithFieldsMutation creates new mutation for the WithFields entity.
+func newWithFieldsMutation(c config, op Op, opts ...withfieldsOption) *WithFieldsMutation {
+	m := &WithFieldsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWithFields,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWithFieldsID sets the ID field of the mutation.
+func withWithFieldsID(id int) withfieldsOption {
+	return func(m *WithFieldsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WithFields
+		)
+		m.oldValue = func(ctx context.Context) (*WithFields, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WithFields.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWithFields sets the old WithFields of the mutation.
+func withWithFields(node *WithFields) withfieldsOption {
+	return func(m *WithFieldsMutation) {
+		m.oldValue = func(context.Context) (*WithFields, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WithFieldsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WithFieldsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WithFieldsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WithFieldsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WithFields.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetExisting sets the "existing" field.
+func (m *WithFieldsMutation) SetExisting(s string) {
+	m.existing = &s
+}
+
+// Existing returns the value of the "existing" field in the mutation.
+func (m *WithFieldsMutation) Existing() (r string, exists bool) {
+	v := m.existing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExisting returns the old "existing" field's value of the WithFields entity.
+// If the WithFields object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithFieldsMutation) OldExisting(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExisting is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExisting requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExisting: %w", err)
+	}
+	return oldValue.Existing, nil
+}
+
+// ResetExisting resets all changes to the "existing" field.
+func (m *WithFieldsMutation) ResetExisting() {
+	m.existing = nil
+}
+
+// Where appends a list predicates to the WithFieldsMutation builder.
+func (m *WithFieldsMutation) Where(ps ...predicate.WithFields) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WithFieldsMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WithFields).
+func (m *WithFieldsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WithFieldsMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.existing != nil {
+		fields = append(fields, withfields.FieldExisting)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WithFieldsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case withfields.FieldExisting:
+		return m.Existing()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WithFieldsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case withfields.FieldExisting:
+		return m.OldExisting(ctx)
+	}
+	return nil, fmt.Errorf("unknown WithFields field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithFieldsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case withfields.FieldExisting:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExisting(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WithFields field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WithFieldsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WithFieldsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithFieldsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WithFields numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WithFieldsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WithFieldsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WithFieldsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WithFields nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WithFieldsMutation) ResetField(name string) error {
+	switch name {
+	case withfields.FieldExisting:
+		m.ResetExisting()
+		return nil
+	}
+	return fmt.Errorf("unknown WithFields field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WithFieldsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WithFieldsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WithFieldsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WithFieldsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WithFieldsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WithFieldsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WithFieldsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WithFields unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WithFieldsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WithFields edge %s", name)
+}
+
+// WithGroupsMutation represents an operation that mutates the WithGroups nodes in the graph.
+type WithGroupsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WithGroups, error)
+	predicates    []predicate.WithGroups
+}
+
+var _ ent.Mutation = (*WithGroupsMutation)(nil)
+
+// withgroupsOption allows management of the mutation configuration using functional options.
+type withgroupsOption func(*WithGroupsMutation)
+
+// newWithGroupsMutation creates new mutation for the WithGroups entity.
+func newWithGroupsMutation(c config, op Op, opts ...withgroupsOption) *WithGroupsMutation {
+	m := &WithGroupsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWithGroups,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWithGroupsID sets the ID field of the mutation.
+func withWithGroupsID(id int) withgroupsOption {
+	return func(m *WithGroupsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WithGroups
+		)
+		m.oldValue = func(ctx context.Context) (*WithGroups, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WithGroups.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWithGroups sets the old WithGroups of the mutation.
+func withWithGroups(node *WithGroups) withgroupsOption {
+	return func(m *WithGroupsMutation) {
+		m.oldValue = func(context.Context) (*WithGroups, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WithGroupsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WithGroupsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WithGroupsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WithGroupsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WithGroups.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *WithGroupsMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WithGroupsMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the WithGroups entity.
+// If the WithGroups object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithGroupsMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WithGroupsMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WithGroupsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WithGroupsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WithGroups entity.
+// If the WithGroups object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithGroupsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WithGroupsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the WithGroupsMutation builder.
+func (m *WithGroupsMutation) Where(ps ...predicate.WithGroups) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WithGroupsMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WithGroups).
+func (m *WithGroupsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WithGroupsMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, withgroups.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, withgroups.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WithGroupsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case withgroups.FieldName:
+		return m.Name()
+	case withgroups.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WithGroupsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case withgroups.FieldName:
+		return m.OldName(ctx)
+	case withgroups.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WithGroups field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithGroupsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case withgroups.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case withgroups.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WithGroups field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WithGroupsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WithGroupsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithGroupsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WithGroups numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WithGroupsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WithGroupsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WithGroupsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WithGroups nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WithGroupsMutation) ResetField(name string) error {
+	switch name {
+	case withgroups.FieldName:
+		m.ResetName()
+		return nil
+	case withgroups.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WithGroups field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WithGroupsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WithGroupsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WithGroupsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WithGroupsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WithGroupsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WithGroupsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WithGroupsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WithGroups unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WithGroupsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WithGroups edge %s", name)
+}
+
+// WithModifiedFieldMutation represents an operation that mutates the WithModifiedField nodes in the graph.
+type WithModifiedFieldMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	clearedFields map[string]struct{}
+	owner         *int
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*WithModifiedField, error)
+	predicates    []predicate.WithModifiedField
+}
+
+var _ ent.Mutation = (*WithModifiedFieldMutation)(nil)
+
+// withmodifiedfieldOption allows management of the mutation configuration using functional options.
+type withmodifiedfieldOption func(*WithModifiedFieldMutation)
+
+// newWithModifiedFieldMutation creates new mutation for the WithModifiedField entity.
+func newWithModifiedFieldMutation(c config, op Op, opts ...withmodifiedfieldOption) *WithModifiedFieldMutation {
+	m := &WithModifiedFieldMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWithModifiedField,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWithModifiedFieldID sets the ID field of the mutation.
+func withWithModifiedFieldID(id int) withmodifiedfieldOption {
+	return func(m *WithModifiedFieldMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WithModifiedField
+		)
+		m.oldValue = func(ctx context.Context) (*WithModifiedField, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WithModifiedField.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWithModifiedField sets the old WithModifiedField of the mutation.
+func withWithModifiedField(node *WithModifiedField) withmodifiedfieldOption {
 	return func(m *WithModifiedFieldMutation) {
 		m.oldValue = func(context.Context) (*WithModifiedField, error) {
 			return node, nil
@@ -659,7 +1597,943 @@ func withWithModifiedField(node *WithModifiedField) withmodifiedfieldOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WithModifiedFieldMutation) Client() *Client {
+func (m WithModifiedFieldMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WithModifiedFieldMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WithModifiedFieldMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WithModifiedFieldMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WithModifiedField.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *WithModifiedFieldMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WithModifiedFieldMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the WithModifiedField entity.
+// If the WithModifiedField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithModifiedFieldMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WithModifiedFieldMutation) ResetName() {
+	m.name = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *WithModifiedFieldMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *WithModifiedFieldMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *WithModifiedFieldMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *WithModifiedFieldMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *WithModifiedFieldMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *WithModifiedFieldMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the WithModifiedFieldMutation builder.
+func (m *WithModifiedFieldMutation) Where(ps ...predicate.WithModifiedField) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WithModifiedFieldMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WithModifiedField).
+func (m *WithModifiedFieldMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WithModifiedFieldMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, withmodifiedfield.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WithModifiedFieldMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case withmodifiedfield.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WithModifiedFieldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case withmodifiedfield.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown WithModifiedField field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithModifiedFieldMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case withmodifiedfield.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WithModifiedField field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WithModifiedFieldMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WithModifiedFieldMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithModifiedFieldMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WithModifiedField numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WithModifiedFieldMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WithModifiedFieldMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WithModifiedFieldMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WithModifiedField nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WithModifiedFieldMutation) ResetField(name string) error {
+	switch name {
+	case withmodifiedfield.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown WithModifiedField field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WithModifiedFieldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, withmodifiedfield.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WithModifiedFieldMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case withmodifiedfield.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WithModifiedFieldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WithModifiedFieldMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WithModifiedFieldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, withmodifiedfield.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WithModifiedFieldMutation) EdgeCleared(name string) bool {
+	switch name {
+	case withmodifiedfield.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WithModifiedFieldMutation) ClearEdge(name string) error {
+	switch name {
+	case withmodifiedfield.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown WithModifiedField unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WithModifiedFieldMutation) ResetEdge(name string) error {
+	switch name {
+	case withmodifiedfield.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown WithModifiedField edge %s", name)
+}
+
+// WithNilFieldsMutation represents an operation that mutates the WithNilFields nodes in the graph.
+type WithNilFieldsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WithNilFields, error)
+	predicates    []predicate.WithNilFields
+}
+
+var _ ent.Mutation = (*WithNilFieldsMutation)(nil)
+
+// withnilfieldsOption allows management of the mutation configuration using functional options.
+type withnilfieldsOption func(*WithNilFieldsMutation)
+
+// newWithNilFieldsMutation creates new mutation for the WithNilFields entity.
+func newWithNilFieldsMutation(c config, op Op, opts ...withnilfieldsOption) *WithNilFieldsMutation {
+	m := &WithNilFieldsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWithNilFields,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWithNilFieldsID sets the ID field of the mutation.
+func withWithNilFieldsID(id int) withnilfieldsOption {
+	return func(m *WithNilFieldsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WithNilFields
+		)
+		m.oldValue = func(ctx context.Context) (*WithNilFields, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WithNilFields.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWithNilFields sets the old WithNilFields of the mutation.
+func withWithNilFields(node *WithNilFields) withnilfieldsOption {
+	return func(m *WithNilFieldsMutation) {
+		m.oldValue = func(context.Context) (*WithNilFields, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WithNilFieldsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WithNilFieldsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WithNilFieldsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WithNilFieldsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WithNilFields.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// Where appends a list predicates to the WithNilFieldsMutation builder.
+func (m *WithNilFieldsMutation) Where(ps ...predicate.WithNilFields) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WithNilFieldsMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WithNilFields).
+func (m *WithNilFieldsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WithNilFieldsMutation) Fields() []string {
+	fields := make([]string, 0, 0)
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WithNilFieldsMutation) Field(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WithNilFieldsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown WithNilFields field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithNilFieldsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WithNilFields field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WithNilFieldsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WithNilFieldsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithNilFieldsMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown WithNilFields numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WithNilFieldsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WithNilFieldsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WithNilFieldsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WithNilFields nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WithNilFieldsMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown WithNilFields field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WithNilFieldsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WithNilFieldsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WithNilFieldsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WithNilFieldsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WithNilFieldsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WithNilFieldsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WithNilFieldsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WithNilFields unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WithNilFieldsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WithNilFields edge %s", name)
+}
+
+// WithSplitFieldsMutation represents an operation that mutates the WithSplitFields nodes in the graph.
+type WithSplitFieldsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	existing      *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WithSplitFields, error)
+	predicates    []predicate.WithSplitFields
+}
+
+var _ ent.Mutation = (*WithSplitFieldsMutation)(nil)
+
+// withsplitfieldsOption allows management of the mutation configuration using functional options.
+type withsplitfieldsOption func(*WithSplitFieldsMutation)
+
+// newWithSplitFieldsMutation creates new mutation for the WithSplitFields entity.
+func newWithSplitFieldsMutation(c config, op Op, opts ...withsplitfieldsOption) *WithSplitFieldsMutation {
+	m := &WithSplitFieldsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWithSplitFields,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWithSplitFieldsID sets the ID field of the mutation.
+func withWithSplitFieldsID(id int) withsplitfieldsOption {
+	return func(m *WithSplitFieldsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WithSplitFields
+		)
+		m.oldValue = func(ctx context.Context) (*WithSplitFields, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WithSplitFields.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWithSplitFields sets the old WithSplitFields of the mutation.
+func withWithSplitFields(node *WithSplitFields) withsplitfieldsOption {
+	return func(m *WithSplitFieldsMutation) {
+		m.oldValue = func(context.Context) (*WithSplitFields, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WithSplitFieldsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WithSplitFieldsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WithSplitFieldsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WithSplitFieldsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WithSplitFields.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetExisting sets the "existing" field.
+func (m *WithSplitFieldsMutation) SetExisting(s string) {
+	m.existing = &s
+}
+
+// Existing returns the value of the "existing" field in the mutation.
+func (m *WithSplitFieldsMutation) Existing() (r string, exists bool) {
+	v := m.existing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExisting returns the old "existing" field's value of the WithSplitFields entity.
+// If the WithSplitFields object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithSplitFieldsMutation) OldExisting(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExisting is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExisting requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExisting: %w", err)
+	}
+	return oldValue.Existing, nil
+}
+
+// ResetExisting resets all changes to the "existing" field.
+func (m *WithSplitFieldsMutation) ResetExisting() {
+	m.existing = nil
+}
+
+// Where appends a list predicates to the WithSplitFieldsMutation builder.
+func (m *WithSplitFieldsMutation) Where(ps ...predicate.WithSplitFields) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WithSplitFieldsMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WithSplitFields).
+func (m *WithSplitFieldsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WithSplitFieldsMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.existing != nil {
+		fields = append(fields, withsplitfields.FieldExisting)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WithSplitFieldsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case withsplitfields.FieldExisting:
+		return m.Existing()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WithSplitFieldsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case withsplitfields.FieldExisting:
+		return m.OldExisting(ctx)
+	}
+	return nil, fmt.Errorf("unknown WithSplitFields field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithSplitFieldsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case withsplitfields.FieldExisting:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExisting(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WithSplitFields field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WithSplitFieldsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WithSplitFieldsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WithSplitFieldsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WithSplitFields numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WithSplitFieldsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WithSplitFieldsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WithSplitFieldsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WithSplitFields nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WithSplitFieldsMutation) ResetField(name string) error {
+	switch name {
+	case withsplitfields.FieldExisting:
+		m.ResetExisting()
+		return nil
+	}
+	return fmt.Errorf("unknown WithSplitFields field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WithSplitFieldsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WithSplitFieldsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WithSplitFieldsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WithSplitFieldsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WithSplitFieldsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WithSplitFieldsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WithSplitFieldsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WithSplitFields unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WithSplitFieldsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WithSplitFields edge %s", name)
+}
+
+// WithUUIDIDMutation represents an operation that mutates the WithUUIDID nodes in the graph.
+type WithUUIDIDMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WithUUIDID, error)
+	predicates    []predicate.WithUUIDID
+}
+
+var _ ent.Mutation = (*WithUUIDIDMutation)(nil)
+
+// withuuididOption allows management of the mutation configuration using functional options.
+type withuuididOption func(*WithUUIDIDMutation)
+
+// newWithUUIDIDMutation creates new mutation for the WithUUIDID entity.
+func newWithUUIDIDMutation(c config, op Op, opts ...withuuididOption) *WithUUIDIDMutation {
+	m := &WithUUIDIDMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWithUUIDID,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWithUUIDIDID sets the ID field of the mutation.
+func withWithUUIDIDID(id uuid.UUID) withuuididOption {
+	return func(m *WithUUIDIDMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WithUUIDID
+		)
+		m.oldValue = func(ctx context.Context) (*WithUUIDID, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WithUUIDID.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWithUUIDID sets the old WithUUIDID of the mutation.
+func withWithUUIDID(node *WithUUIDID) withuuididOption {
+	return func(m *WithUUIDIDMutation) {
+		m.oldValue = func(context.Context) (*WithUUIDID, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WithUUIDIDMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -667,7 +2541,7 @@ func (m WithModifiedFieldMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WithModifiedFieldMutation) Tx() (*Tx, error) {
+func (m WithUUIDIDMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -676,9 +2550,15 @@ func (m WithModifiedFieldMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WithUUIDID entities.
+func (m *WithUUIDIDMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *WithModifiedFieldMutation) ID() (id int, exists bool) {
+func (m *WithUUIDIDMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -689,28 +2569,28 @@ func (m *WithModifiedFieldMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *WithModifiedFieldMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WithUUIDIDMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().WithModifiedField.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WithUUIDID.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *WithModifiedFieldMutation) SetName(s string) {
+func (m *WithUUIDIDMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *WithModifiedFieldMutation) Name() (r string, exists bool) {
+func (m *WithUUIDIDMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -718,10 +2598,10 @@ func (m *WithModifiedFieldMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the WithModifiedField entity.
-// If the WithModifiedField object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the WithUUIDID entity.
+// If the WithUUIDID object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WithModifiedFieldMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WithUUIDIDMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -736,71 +2616,32 @@ func (m *WithModifiedFieldMutation) OldName(ctx context.Context) (v string, err
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *WithModifiedFieldMutation) ResetName() {
+func (m *WithUUIDIDMutation) ResetName() {
 	m.name = nil
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *WithModifiedFieldMutation) SetOwnerID(id int) {
-	m.owner = &id
-}
-
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *WithModifiedFieldMutation) ClearOwner() {
-	m.clearedowner = true
-}
-
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *WithModifiedFieldMutation) OwnerCleared() bool {
-	return m.clearedowner
-}
-
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *WithModifiedFieldMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
-	}
-	return
-}
-
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *WithModifiedFieldMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetOwner resets all changes to the "owner" edge.
-func (m *WithModifiedFieldMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
-}
-
-// Where appends a list predicates to the WithModifiedFieldMutation builder.
-func (m *WithModifiedFieldMutation) Where(ps ...predicate.WithModifiedField) {
+// Where appends a list predicates to the WithUUIDIDMutation builder.
+func (m *WithUUIDIDMutation) Where(ps ...predicate.WithUUIDID) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *WithModifiedFieldMutation) Op() Op {
+func (m *WithUUIDIDMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (WithModifiedField).
-func (m *WithModifiedFieldMutation) Type() string {
+// Type returns the node type of this mutation (WithUUIDID).
+func (m *WithUUIDIDMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WithModifiedFieldMutation) Fields() []string {
+func (m *WithUUIDIDMutation) Fields() []string {
 	fields := make([]string, 0, 1)
 	if m.name != nil {
-		fields = append(fields, withmodifiedfield.FieldName)
+		fields = append(fields, withuuidid.FieldName)
 	}
 	return fields
 }
@@ -808,9 +2649,9 @@ func (m *WithModifiedFieldMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WithModifiedFieldMutation) Field(name string) (ent.Value, bool) {
+func (m *WithUUIDIDMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case withmodifiedfield.FieldName:
+	case withuuidid.FieldName:
 		return m.Name()
 	}
 	return nil, false
@@ -819,20 +2660,20 @@ func (m *WithModifiedFieldMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WithModifiedFieldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WithUUIDIDMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case withmodifiedfield.FieldName:
+	case withuuidid.FieldName:
 		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown WithModifiedField field %s", name)
+	return nil, fmt.Errorf("unknown WithUUIDID field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WithModifiedFieldMutation) SetField(name string, value ent.Value) error {
+func (m *WithUUIDIDMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case withmodifiedfield.FieldName:
+	case withuuidid.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -840,158 +2681,134 @@ func (m *WithModifiedFieldMutation) SetField(name string, value ent.Value) error
 		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown WithModifiedField field %s", name)
+	return fmt.Errorf("unknown WithUUIDID field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WithModifiedFieldMutation) AddedFields() []string {
+func (m *WithUUIDIDMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WithModifiedFieldMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WithUUIDIDMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WithModifiedFieldMutation) AddField(name string, value ent.Value) error {
+func (m *WithUUIDIDMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown WithModifiedField numeric field %s", name)
+	return fmt.Errorf("unknown WithUUIDID numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WithModifiedFieldMutation) ClearedFields() []string {
+func (m *WithUUIDIDMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WithModifiedFieldMutation) FieldCleared(name string) bool {
+func (m *WithUUIDIDMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WithModifiedFieldMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown WithModifiedField nullable field %s", name)
+func (m *WithUUIDIDMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WithUUIDID nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WithModifiedFieldMutation) ResetField(name string) error {
+func (m *WithUUIDIDMutation) ResetField(name string) error {
 	switch name {
-	case withmodifiedfield.FieldName:
+	case withuuidid.FieldName:
 		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown WithModifiedField field %s", name)
+	return fmt.Errorf("unknown WithUUIDID field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WithModifiedFieldMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.owner != nil {
-		edges = append(edges, withmodifiedfield.EdgeOwner)
-	}
+func (m *WithUUIDIDMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WithModifiedFieldMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case withmodifiedfield.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *WithUUIDIDMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WithModifiedFieldMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WithUUIDIDMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WithModifiedFieldMutation) RemovedIDs(name string) []ent.Value {
+func (m *WithUUIDIDMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WithModifiedFieldMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedowner {
-		edges = append(edges, withmodifiedfield.EdgeOwner)
-	}
+func (m *WithUUIDIDMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WithModifiedFieldMutation) EdgeCleared(name string) bool {
-	switch name {
-	case withmodifiedfield.EdgeOwner:
-		return m.clearedowner
-	}
+func (m *WithUUIDIDMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WithModifiedFieldMutation) ClearEdge(name string) error {
-	switch name {
-	case withmodifiedfield.EdgeOwner:
-		m.ClearOwner()
-		return nil
-	}
-	return fmt.Errorf("unknown WithModifiedField unique edge %s", name)
+func (m *WithUUIDIDMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WithUUIDID unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WithModifiedFieldMutation) ResetEdge(name string) error {
-	switch name {
-	case withmodifiedfield.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	}
-	return fmt.Errorf("unknown WithModifiedField edge %s", name)
+func (m *WithUUIDIDMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WithUUIDID edge %s", name)
 }
 
-// WithNilFieldsMutation represents an operation that mutates the WithNilFields nodes in the graph.
-type WithNilFieldsMutation struct {
+// WithValidatorMutation represents an operation that mutates the WithValidator nodes in the graph.
+type WithValidatorMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	name          *string
+	slug          *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*WithNilFields, error)
-	predicates    []predicate.WithNilFields
+	oldValue      func(context.Context) (*WithValidator, error)
+	predicates    []predicate.WithValidator
 }
 
-var _ ent.Mutation = (*WithNilFieldsMutation)(nil)
+var _ ent.Mutation = (*WithValidatorMutation)(nil)
 
-// withnilfieldsOption allows management of the mutation configuration using functional options.
-type withnilfieldsOption func(*WithNilFieldsMutation)
+// withvalidatorOption allows management of the mutation configuration using functional options.
+type withvalidatorOption func(*WithValidatorMutation)
 
-// newWithNilFieldsMutation creates new mutation for the WithNilFields entity.
-func newWithNilFieldsMutation(c config, op Op, opts ...withnilfieldsOption) *WithNilFieldsMutation {
-	m := &WithNilFieldsMutation{
+// newWithValidatorMutation creates new mutation for the WithValidator entity.
+func newWithValidatorMutation(c config, op Op, opts ...withvalidatorOption) *WithValidatorMutation {
+	m := &WithValidatorMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWithNilFields,
+		typ:           TypeWithValidator,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1000,20 +2817,20 @@ func newWithNilFieldsMutation(c config, op Op, opts ...withnilfieldsOption) *Wit
 	return m
 }
 
-// withWithNilFieldsID sets the ID field of the mutation.
-func withWithNilFieldsID(id int) withnilfieldsOption {
-	return func(m *WithNilFieldsMutation) {
+// withWithValidatorID sets the ID field of the mutation.
+func withWithValidatorID(id int) withvalidatorOption {
+	return func(m *WithValidatorMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *WithNilFields
+			value *WithValidator
 		)
-		m.oldValue = func(ctx context.Context) (*WithNilFields, error) {
+		m.oldValue = func(ctx context.Context) (*WithValidator, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().WithNilFields.Get(ctx, id)
+					value, err = m.Client().WithValidator.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1022,10 +2839,10 @@ func withWithNilFieldsID(id int) withnilfieldsOption {
 	}
 }
 
-// withWithNilFields sets the old WithNilFields of the mutation.
-func withWithNilFields(node *WithNilFields) withnilfieldsOption {
-	return func(m *WithNilFieldsMutation) {
-		m.oldValue = func(context.Context) (*WithNilFields, error) {
+// withWithValidator sets the old WithValidator of the mutation.
+func withWithValidator(node *WithValidator) withvalidatorOption {
+	return func(m *WithValidatorMutation) {
+		m.oldValue = func(context.Context) (*WithValidator, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1034,7 +2851,7 @@ func withWithNilFields(node *WithNilFields) withnilfieldsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WithNilFieldsMutation) Client() *Client {
+func (m WithValidatorMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1042,7 +2859,7 @@ func (m WithNilFieldsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WithNilFieldsMutation) Tx() (*Tx, error) {
+func (m WithValidatorMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1053,7 +2870,7 @@ func (m WithNilFieldsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *WithNilFieldsMutation) ID() (id int, exists bool) {
+func (m *WithValidatorMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1064,7 +2881,7 @@ func (m *WithNilFieldsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *WithNilFieldsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WithValidatorMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1073,149 +2890,263 @@ func (m *WithNilFieldsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().WithNilFields.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WithValidator.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// Where appends a list predicates to the WithNilFieldsMutation builder.
-func (m *WithNilFieldsMutation) Where(ps ...predicate.WithNilFields) {
+// SetName sets the "name" field.
+func (m *WithValidatorMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WithValidatorMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the WithValidator entity.
+// If the WithValidator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithValidatorMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WithValidatorMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *WithValidatorMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *WithValidatorMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the WithValidator entity.
+// If the WithValidator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WithValidatorMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *WithValidatorMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// Where appends a list predicates to the WithValidatorMutation builder.
+func (m *WithValidatorMutation) Where(ps ...predicate.WithValidator) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *WithNilFieldsMutation) Op() Op {
+func (m *WithValidatorMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (WithNilFields).
-func (m *WithNilFieldsMutation) Type() string {
+// Type returns the node type of this mutation (WithValidator).
+func (m *WithValidatorMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WithNilFieldsMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *WithValidatorMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, withvalidator.FieldName)
+	}
+	if m.slug != nil {
+		fields = append(fields, withvalidator.FieldSlug)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WithNilFieldsMutation) Field(name string) (ent.Value, bool) {
+func (m *WithValidatorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case withvalidator.FieldName:
+		return m.Name()
+	case withvalidator.FieldSlug:
+		return m.Slug()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WithNilFieldsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown WithNilFields field %s", name)
+func (m *WithValidatorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case withvalidator.FieldName:
+		return m.OldName(ctx)
+	case withvalidator.FieldSlug:
+		return m.OldSlug(ctx)
+	}
+	return nil, fmt.Errorf("unknown WithValidator field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WithNilFieldsMutation) SetField(name string, value ent.Value) error {
+func (m *WithValidatorMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case withvalidator.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case withvalidator.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
 	}
-	return fmt.Errorf("unknown WithNilFields field %s", name)
+	return fmt.Errorf("unknown WithValidator field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WithNilFieldsMutation) AddedFields() []string {
+func (m *WithValidatorMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WithNilFieldsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WithValidatorMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WithNilFieldsMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown WithNilFields numeric field %s", name)
+func (m *WithValidatorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WithValidator numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WithNilFieldsMutation) ClearedFields() []string {
+func (m *WithValidatorMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WithNilFieldsMutation) FieldCleared(name string) bool {
+func (m *WithValidatorMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WithNilFieldsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown WithNilFields nullable field %s", name)
+func (m *WithValidatorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WithValidator nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WithNilFieldsMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown WithNilFields field %s", name)
+func (m *WithValidatorMutation) ResetField(name string) error {
+	switch name {
+	case withvalidator.FieldName:
+		m.ResetName()
+		return nil
+	case withvalidator.FieldSlug:
+		m.ResetSlug()
+		return nil
+	}
+	return fmt.Errorf("unknown WithValidator field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WithNilFieldsMutation) AddedEdges() []string {
+func (m *WithValidatorMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WithNilFieldsMutation) AddedIDs(name string) []ent.Value {
+func (m *WithValidatorMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WithNilFieldsMutation) RemovedEdges() []string {
+func (m *WithValidatorMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WithNilFieldsMutation) RemovedIDs(name string) []ent.Value {
+func (m *WithValidatorMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WithNilFieldsMutation) ClearedEdges() []string {
+func (m *WithValidatorMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WithNilFieldsMutation) EdgeCleared(name string) bool {
+func (m *WithValidatorMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WithNilFieldsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown WithNilFields unique edge %s", name)
+func (m *WithValidatorMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WithValidator unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WithNilFieldsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown WithNilFields edge %s", name)
+func (m *WithValidatorMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WithValidator edge %s", name)
 }
 
 // WithoutFieldsMutation represents an operation that mutates the WithoutFields nodes in the graph.
@@ -6,17 +6,35 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// BaseSchema is the predicate function for baseschema builders.
+type BaseSchema func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// WithBaseSchema is the predicate function for withbaseschema builders.
+type WithBaseSchema func(*sql.Selector)
+
 // WithFields is the predicate function for withfields builders.
 type WithFields func(*sql.Selector)
 
+// WithGroups is the predicate function for withgroups builders.
+type WithGroups func(*sql.Selector)
+
 // WithModifiedField is the predicate function for withmodifiedfield builders.
 type WithModifiedField func(*sql.Selector)
 
 // WithNilFields is the predicate function for withnilfields builders.
 type WithNilFields func(*sql.Selector)
 
+// WithSplitFields is the predicate function for withsplitfields builders.
+type WithSplitFields func(*sql.Selector)
+
+// WithUUIDID is the predicate function for withuuidid builders.
+type WithUUIDID func(*sql.Selector)
+
+// WithValidator is the predicate function for withvalidator builders.
+type WithValidator func(*sql.Selector)
+
 // WithoutFields is the predicate function for withoutfields builders.
 type WithoutFields func(*sql.Selector)
@@ -5,6 +5,9 @@ package ent
 import (
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/schema"
 	"entgo.io/contrib/schemast/internal/mutatetest/ent/withmodifiedfield"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withuuidid"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withvalidator"
+	"github.com/google/uuid"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -31,4 +34,16 @@ func init() {
 			return nil
 		}
 	}()
+	withuuididFields := schema.WithUUIDID{}.Fields()
+	_ = withuuididFields
+	// withuuididDescID is the schema descriptor for id field.
+	withuuididDescID := withuuididFields[0].Descriptor()
+	// withuuidid.DefaultID holds the default value on creation for the id field.
+	withuuidid.DefaultID = withuuididDescID.Default.(func() uuid.UUID)
+	withvalidatorFields := schema.WithValidator{}.Fields()
+	_ = withvalidatorFields
+	// withvalidatorDescSlug is the schema descriptor for slug field.
+	withvalidatorDescSlug := withvalidatorFields[1].Descriptor()
+	// withvalidator.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	withvalidator.SlugValidator = withvalidatorDescSlug.Validators[0].(func(string) error)
 }
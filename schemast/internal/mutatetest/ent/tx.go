@@ -12,14 +12,26 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// BaseSchema is the client for interacting with the BaseSchema builders.
+	BaseSchema *BaseSchemaClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WithBaseSchema is the client for interacting with the WithBaseSchema builders.
+	WithBaseSchema *WithBaseSchemaClient
 	// WithFields is the client for interacting with the WithFields builders.
 	WithFields *WithFieldsClient
+	// WithGroups is the client for interacting with the WithGroups builders.
+	WithGroups *WithGroupsClient
 	// WithModifiedField is the client for interacting with the WithModifiedField builders.
 	WithModifiedField *WithModifiedFieldClient
 	// WithNilFields is the client for interacting with the WithNilFields builders.
 	WithNilFields *WithNilFieldsClient
+	// WithSplitFields is the client for interacting with the WithSplitFields builders.
+	WithSplitFields *WithSplitFieldsClient
+	// WithUUIDID is the client for interacting with the WithUUIDID builders.
+	WithUUIDID *WithUUIDIDClient
+	// WithValidator is the client for interacting with the WithValidator builders.
+	WithValidator *WithValidatorClient
 	// WithoutFields is the client for interacting with the WithoutFields builders.
 	WithoutFields *WithoutFieldsClient
 
@@ -157,10 +169,16 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.BaseSchema = NewBaseSchemaClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WithBaseSchema = NewWithBaseSchemaClient(tx.config)
 	tx.WithFields = NewWithFieldsClient(tx.config)
+	tx.WithGroups = NewWithGroupsClient(tx.config)
 	tx.WithModifiedField = NewWithModifiedFieldClient(tx.config)
 	tx.WithNilFields = NewWithNilFieldsClient(tx.config)
+	tx.WithSplitFields = NewWithSplitFieldsClient(tx.config)
+	tx.WithUUIDID = NewWithUUIDIDClient(tx.config)
+	tx.WithValidator = NewWithValidatorClient(tx.config)
 	tx.WithoutFields = NewWithoutFieldsClient(tx.config)
 }
 
@@ -171,7 +189,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: BaseSchema.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,99 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withbaseschema"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WithBaseSchema is the model entity for the WithBaseSchema schema.
+type WithBaseSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WithBaseSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case withbaseschema.FieldID:
+			values[i] = new(sql.NullInt64)
+		case withbaseschema.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WithBaseSchema", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WithBaseSchema fields.
+func (wbs *WithBaseSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case withbaseschema.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wbs.ID = int(value.Int64)
+		case withbaseschema.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				wbs.Name = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this WithBaseSchema.
+// Note that you need to call WithBaseSchema.Unwrap() before calling this method if this WithBaseSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wbs *WithBaseSchema) Update() *WithBaseSchemaUpdateOne {
+	return (&WithBaseSchemaClient{config: wbs.config}).UpdateOne(wbs)
+}
+
+// Unwrap unwraps the WithBaseSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wbs *WithBaseSchema) Unwrap() *WithBaseSchema {
+	_tx, ok := wbs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WithBaseSchema is not a transactional entity")
+	}
+	wbs.config.driver = _tx.drv
+	return wbs
+}
+
+// String implements the fmt.Stringer.
+func (wbs *WithBaseSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("WithBaseSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wbs.ID))
+	builder.WriteString("name=")
+	builder.WriteString(wbs.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WithBaseSchemas is a parsable slice of WithBaseSchema.
+type WithBaseSchemas []*WithBaseSchema
+
+func (wbs WithBaseSchemas) config(cfg config) {
+	for _i := range wbs {
+		wbs[_i].config = cfg
+	}
+}
@@ -0,0 +1,30 @@
+// Code generated by ent, DO NOT EDIT.
+
+package withbaseschema
+
+const (
+	// Label holds the string label denoting the withbaseschema type in the database.
+	Label = "with_base_schema"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// Table holds the table name of the withbaseschema in the database.
+	Table = "with_base_schemas"
+)
+
+// Columns holds all SQL columns for withbaseschema fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withbaseschema"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithBaseSchemaCreate is the builder for creating a WithBaseSchema entity.
+type WithBaseSchemaCreate struct {
+	config
+	mutation *WithBaseSchemaMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (wbsc *WithBaseSchemaCreate) SetName(s string) *WithBaseSchemaCreate {
+	wbsc.mutation.SetName(s)
+	return wbsc
+}
+
+// Mutation returns the WithBaseSchemaMutation object of the builder.
+func (wbsc *WithBaseSchemaCreate) Mutation() *WithBaseSchemaMutation {
+	return wbsc.mutation
+}
+
+// Save creates the WithBaseSchema in the database.
+func (wbsc *WithBaseSchemaCreate) Save(ctx context.Context) (*WithBaseSchema, error) {
+	var (
+		err  error
+		node *WithBaseSchema
+	)
+	if len(wbsc.hooks) == 0 {
+		if err = wbsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wbsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithBaseSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wbsc.check(); err != nil {
+				return nil, err
+			}
+			wbsc.mutation = mutation
+			if node, err = wbsc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wbsc.hooks) - 1; i >= 0; i-- {
+			if wbsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wbsc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wbsc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WithBaseSchema)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WithBaseSchemaMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wbsc *WithBaseSchemaCreate) SaveX(ctx context.Context) *WithBaseSchema {
+	v, err := wbsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wbsc *WithBaseSchemaCreate) Exec(ctx context.Context) error {
+	_, err := wbsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wbsc *WithBaseSchemaCreate) ExecX(ctx context.Context) {
+	if err := wbsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wbsc *WithBaseSchemaCreate) check() error {
+	if _, ok := wbsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WithBaseSchema.name"`)}
+	}
+	return nil
+}
+
+func (wbsc *WithBaseSchemaCreate) sqlSave(ctx context.Context) (*WithBaseSchema, error) {
+	_node, _spec := wbsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wbsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wbsc *WithBaseSchemaCreate) createSpec() (*WithBaseSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WithBaseSchema{config: wbsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: withbaseschema.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withbaseschema.FieldID,
+			},
+		}
+	)
+	if value, ok := wbsc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withbaseschema.FieldName,
+		})
+		_node.Name = value
+	}
+	return _node, _spec
+}
+
+// WithBaseSchemaCreateBulk is the builder for creating many WithBaseSchema entities in bulk.
+type WithBaseSchemaCreateBulk struct {
+	config
+	builders []*WithBaseSchemaCreate
+}
+
+// Save creates the WithBaseSchema entities in the database.
+func (wbscb *WithBaseSchemaCreateBulk) Save(ctx context.Context) ([]*WithBaseSchema, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wbscb.builders))
+	nodes := make([]*WithBaseSchema, len(wbscb.builders))
+	mutators := make([]Mutator, len(wbscb.builders))
+	for i := range wbscb.builders {
+		func(i int, root context.Context) {
+			builder := wbscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WithBaseSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wbscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wbscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wbscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wbscb *WithBaseSchemaCreateBulk) SaveX(ctx context.Context) []*WithBaseSchema {
+	v, err := wbscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wbscb *WithBaseSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := wbscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wbscb *WithBaseSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := wbscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withbaseschema"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithBaseSchemaDelete is the builder for deleting a WithBaseSchema entity.
+type WithBaseSchemaDelete struct {
+	config
+	hooks    []Hook
+	mutation *WithBaseSchemaMutation
+}
+
+// Where appends a list predicates to the WithBaseSchemaDelete builder.
+func (wbsd *WithBaseSchemaDelete) Where(ps ...predicate.WithBaseSchema) *WithBaseSchemaDelete {
+	wbsd.mutation.Where(ps...)
+	return wbsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wbsd *WithBaseSchemaDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wbsd.hooks) == 0 {
+		affected, err = wbsd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithBaseSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wbsd.mutation = mutation
+			affected, err = wbsd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wbsd.hooks) - 1; i >= 0; i-- {
+			if wbsd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wbsd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wbsd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wbsd *WithBaseSchemaDelete) ExecX(ctx context.Context) int {
+	n, err := wbsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wbsd *WithBaseSchemaDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: withbaseschema.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withbaseschema.FieldID,
+			},
+		},
+	}
+	if ps := wbsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wbsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// WithBaseSchemaDeleteOne is the builder for deleting a single WithBaseSchema entity.
+type WithBaseSchemaDeleteOne struct {
+	wbsd *WithBaseSchemaDelete
+}
+
+// Exec executes the deletion query.
+func (wbsdo *WithBaseSchemaDeleteOne) Exec(ctx context.Context) error {
+	n, err := wbsdo.wbsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{withbaseschema.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wbsdo *WithBaseSchemaDeleteOne) ExecX(ctx context.Context) {
+	wbsdo.wbsd.ExecX(ctx)
+}
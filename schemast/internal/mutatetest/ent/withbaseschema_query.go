@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withbaseschema"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithBaseSchemaQuery is the builder for querying WithBaseSchema entities.
+type WithBaseSchemaQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WithBaseSchema
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WithBaseSchemaQuery builder.
+func (wbsq *WithBaseSchemaQuery) Where(ps ...predicate.WithBaseSchema) *WithBaseSchemaQuery {
+	wbsq.predicates = append(wbsq.predicates, ps...)
+	return wbsq
+}
+
+// Limit adds a limit step to the query.
+func (wbsq *WithBaseSchemaQuery) Limit(limit int) *WithBaseSchemaQuery {
+	wbsq.limit = &limit
+	return wbsq
+}
+
+// Offset adds an offset step to the query.
+func (wbsq *WithBaseSchemaQuery) Offset(offset int) *WithBaseSchemaQuery {
+	wbsq.offset = &offset
+	return wbsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wbsq *WithBaseSchemaQuery) Unique(unique bool) *WithBaseSchemaQuery {
+	wbsq.unique = &unique
+	return wbsq
+}
+
+// Order adds an order step to the query.
+func (wbsq *WithBaseSchemaQuery) Order(o ...OrderFunc) *WithBaseSchemaQuery {
+	wbsq.order = append(wbsq.order, o...)
+	return wbsq
+}
+
+// First returns the first WithBaseSchema entity from the query.
+// Returns a *NotFoundError when no WithBaseSchema was found.
+func (wbsq *WithBaseSchemaQuery) First(ctx context.Context) (*WithBaseSchema, error) {
+	nodes, err := wbsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{withbaseschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wbsq *WithBaseSchemaQuery) FirstX(ctx context.Context) *WithBaseSchema {
+	node, err := wbsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WithBaseSchema ID from the query.
+// Returns a *NotFoundError when no WithBaseSchema ID was found.
+func (wbsq *WithBaseSchemaQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wbsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{withbaseschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wbsq *WithBaseSchemaQuery) FirstIDX(ctx context.Context) int {
+	id, err := wbsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WithBaseSchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WithBaseSchema entity is found.
+// Returns a *NotFoundError when no WithBaseSchema entities are found.
+func (wbsq *WithBaseSchemaQuery) Only(ctx context.Context) (*WithBaseSchema, error) {
+	nodes, err := wbsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{withbaseschema.Label}
+	default:
+		return nil, &NotSingularError{withbaseschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wbsq *WithBaseSchemaQuery) OnlyX(ctx context.Context) *WithBaseSchema {
+	node, err := wbsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WithBaseSchema ID in the query.
+// Returns a *NotSingularError when more than one WithBaseSchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wbsq *WithBaseSchemaQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wbsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{withbaseschema.Label}
+	default:
+		err = &NotSingularError{withbaseschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wbsq *WithBaseSchemaQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wbsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WithBaseSchemas.
+func (wbsq *WithBaseSchemaQuery) All(ctx context.Context) ([]*WithBaseSchema, error) {
+	if err := wbsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wbsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wbsq *WithBaseSchemaQuery) AllX(ctx context.Context) []*WithBaseSchema {
+	nodes, err := wbsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WithBaseSchema IDs.
+func (wbsq *WithBaseSchemaQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wbsq.Select(withbaseschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wbsq *WithBaseSchemaQuery) IDsX(ctx context.Context) []int {
+	ids, err := wbsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wbsq *WithBaseSchemaQuery) Count(ctx context.Context) (int, error) {
+	if err := wbsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wbsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wbsq *WithBaseSchemaQuery) CountX(ctx context.Context) int {
+	count, err := wbsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wbsq *WithBaseSchemaQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wbsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wbsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wbsq *WithBaseSchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := wbsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WithBaseSchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wbsq *WithBaseSchemaQuery) Clone() *WithBaseSchemaQuery {
+	if wbsq == nil {
+		return nil
+	}
+	return &WithBaseSchemaQuery{
+		config:     wbsq.config,
+		limit:      wbsq.limit,
+		offset:     wbsq.offset,
+		order:      append([]OrderFunc{}, wbsq.order...),
+		predicates: append([]predicate.WithBaseSchema{}, wbsq.predicates...),
+		// clone intermediate query.
+		sql:    wbsq.sql.Clone(),
+		path:   wbsq.path,
+		unique: wbsq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WithBaseSchema.Query().
+//		GroupBy(withbaseschema.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wbsq *WithBaseSchemaQuery) GroupBy(field string, fields ...string) *WithBaseSchemaGroupBy {
+	grbuild := &WithBaseSchemaGroupBy{config: wbsq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wbsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wbsq.sqlQuery(ctx), nil
+	}
+	grbuild.label = withbaseschema.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.WithBaseSchema.Query().
+//		Select(withbaseschema.FieldName).
+//		Scan(ctx, &v)
+func (wbsq *WithBaseSchemaQuery) Select(fields ...string) *WithBaseSchemaSelect {
+	wbsq.fields = append(wbsq.fields, fields...)
+	selbuild := &WithBaseSchemaSelect{WithBaseSchemaQuery: wbsq}
+	selbuild.label = withbaseschema.Label
+	selbuild.flds, selbuild.scan = &wbsq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (wbsq *WithBaseSchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wbsq.fields {
+		if !withbaseschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wbsq.path != nil {
+		prev, err := wbsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wbsq.sql = prev
+	}
+	return nil
+}
+
+func (wbsq *WithBaseSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WithBaseSchema, error) {
+	var (
+		nodes = []*WithBaseSchema{}
+		_spec = wbsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WithBaseSchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WithBaseSchema{config: wbsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wbsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wbsq *WithBaseSchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wbsq.querySpec()
+	_spec.Node.Columns = wbsq.fields
+	if len(wbsq.fields) > 0 {
+		_spec.Unique = wbsq.unique != nil && *wbsq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wbsq.driver, _spec)
+}
+
+func (wbsq *WithBaseSchemaQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wbsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wbsq *WithBaseSchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withbaseschema.Table,
+			Columns: withbaseschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withbaseschema.FieldID,
+			},
+		},
+		From:   wbsq.sql,
+		Unique: true,
+	}
+	if unique := wbsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wbsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withbaseschema.FieldID)
+		for i := range fields {
+			if fields[i] != withbaseschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wbsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wbsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wbsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wbsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wbsq *WithBaseSchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wbsq.driver.Dialect())
+	t1 := builder.Table(withbaseschema.Table)
+	columns := wbsq.fields
+	if len(columns) == 0 {
+		columns = withbaseschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wbsq.sql != nil {
+		selector = wbsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wbsq.unique != nil && *wbsq.unique {
+		selector.Distinct()
+	}
+	for _, p := range wbsq.predicates {
+		p(selector)
+	}
+	for _, p := range wbsq.order {
+		p(selector)
+	}
+	if offset := wbsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wbsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithBaseSchemaGroupBy is the group-by builder for WithBaseSchema entities.
+type WithBaseSchemaGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wbsgb *WithBaseSchemaGroupBy) Aggregate(fns ...AggregateFunc) *WithBaseSchemaGroupBy {
+	wbsgb.fns = append(wbsgb.fns, fns...)
+	return wbsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wbsgb *WithBaseSchemaGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := wbsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wbsgb.sql = query
+	return wbsgb.sqlScan(ctx, v)
+}
+
+func (wbsgb *WithBaseSchemaGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range wbsgb.fields {
+		if !withbaseschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wbsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wbsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wbsgb *WithBaseSchemaGroupBy) sqlQuery() *sql.Selector {
+	selector := wbsgb.sql.Select()
+	aggregation := make([]string, 0, len(wbsgb.fns))
+	for _, fn := range wbsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wbsgb.fields)+len(wbsgb.fns))
+		for _, f := range wbsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wbsgb.fields...)...)
+}
+
+// WithBaseSchemaSelect is the builder for selecting fields of WithBaseSchema entities.
+type WithBaseSchemaSelect struct {
+	*WithBaseSchemaQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wbss *WithBaseSchemaSelect) Scan(ctx context.Context, v any) error {
+	if err := wbss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wbss.sql = wbss.WithBaseSchemaQuery.sqlQuery(ctx)
+	return wbss.sqlScan(ctx, v)
+}
+
+func (wbss *WithBaseSchemaSelect) sqlScan(ctx context.Context, v any) error {
+	rows := &sql.Rows{}
+	query, args := wbss.sql.Query()
+	if err := wbss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
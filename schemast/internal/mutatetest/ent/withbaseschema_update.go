@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withbaseschema"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithBaseSchemaUpdate is the builder for updating WithBaseSchema entities.
+type WithBaseSchemaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WithBaseSchemaMutation
+}
+
+// Where appends a list predicates to the WithBaseSchemaUpdate builder.
+func (wbsu *WithBaseSchemaUpdate) Where(ps ...predicate.WithBaseSchema) *WithBaseSchemaUpdate {
+	wbsu.mutation.Where(ps...)
+	return wbsu
+}
+
+// SetName sets the "name" field.
+func (wbsu *WithBaseSchemaUpdate) SetName(s string) *WithBaseSchemaUpdate {
+	wbsu.mutation.SetName(s)
+	return wbsu
+}
+
+// Mutation returns the WithBaseSchemaMutation object of the builder.
+func (wbsu *WithBaseSchemaUpdate) Mutation() *WithBaseSchemaMutation {
+	return wbsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wbsu *WithBaseSchemaUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wbsu.hooks) == 0 {
+		affected, err = wbsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithBaseSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wbsu.mutation = mutation
+			affected, err = wbsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wbsu.hooks) - 1; i >= 0; i-- {
+			if wbsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wbsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wbsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wbsu *WithBaseSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := wbsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wbsu *WithBaseSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := wbsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wbsu *WithBaseSchemaUpdate) ExecX(ctx context.Context) {
+	if err := wbsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wbsu *WithBaseSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withbaseschema.Table,
+			Columns: withbaseschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withbaseschema.FieldID,
+			},
+		},
+	}
+	if ps := wbsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wbsu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withbaseschema.FieldName,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wbsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withbaseschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WithBaseSchemaUpdateOne is the builder for updating a single WithBaseSchema entity.
+type WithBaseSchemaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WithBaseSchemaMutation
+}
+
+// SetName sets the "name" field.
+func (wbsuo *WithBaseSchemaUpdateOne) SetName(s string) *WithBaseSchemaUpdateOne {
+	wbsuo.mutation.SetName(s)
+	return wbsuo
+}
+
+// Mutation returns the WithBaseSchemaMutation object of the builder.
+func (wbsuo *WithBaseSchemaUpdateOne) Mutation() *WithBaseSchemaMutation {
+	return wbsuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wbsuo *WithBaseSchemaUpdateOne) Select(field string, fields ...string) *WithBaseSchemaUpdateOne {
+	wbsuo.fields = append([]string{field}, fields...)
+	return wbsuo
+}
+
+// Save executes the query and returns the updated WithBaseSchema entity.
+func (wbsuo *WithBaseSchemaUpdateOne) Save(ctx context.Context) (*WithBaseSchema, error) {
+	var (
+		err  error
+		node *WithBaseSchema
+	)
+	if len(wbsuo.hooks) == 0 {
+		node, err = wbsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithBaseSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wbsuo.mutation = mutation
+			node, err = wbsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wbsuo.hooks) - 1; i >= 0; i-- {
+			if wbsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wbsuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wbsuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WithBaseSchema)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WithBaseSchemaMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wbsuo *WithBaseSchemaUpdateOne) SaveX(ctx context.Context) *WithBaseSchema {
+	node, err := wbsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wbsuo *WithBaseSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := wbsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wbsuo *WithBaseSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := wbsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wbsuo *WithBaseSchemaUpdateOne) sqlSave(ctx context.Context) (_node *WithBaseSchema, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withbaseschema.Table,
+			Columns: withbaseschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withbaseschema.FieldID,
+			},
+		},
+	}
+	id, ok := wbsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WithBaseSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wbsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withbaseschema.FieldID)
+		for _, f := range fields {
+			if !withbaseschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != withbaseschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wbsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wbsuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withbaseschema.FieldName,
+		})
+	}
+	_node = &WithBaseSchema{config: wbsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wbsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withbaseschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withgroups"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WithGroups is the model entity for the WithGroups schema.
+type WithGroups struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WithGroups) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case withgroups.FieldID:
+			values[i] = new(sql.NullInt64)
+		case withgroups.FieldName:
+			values[i] = new(sql.NullString)
+		case withgroups.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WithGroups", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WithGroups fields.
+func (wg *WithGroups) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case withgroups.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wg.ID = int(value.Int64)
+		case withgroups.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				wg.Name = value.String
+			}
+		case withgroups.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wg.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this WithGroups.
+// Note that you need to call WithGroups.Unwrap() before calling this method if this WithGroups
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wg *WithGroups) Update() *WithGroupsUpdateOne {
+	return (&WithGroupsClient{config: wg.config}).UpdateOne(wg)
+}
+
+// Unwrap unwraps the WithGroups entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wg *WithGroups) Unwrap() *WithGroups {
+	_tx, ok := wg.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WithGroups is not a transactional entity")
+	}
+	wg.config.driver = _tx.drv
+	return wg
+}
+
+// String implements the fmt.Stringer.
+func (wg *WithGroups) String() string {
+	var builder strings.Builder
+	builder.WriteString("WithGroups(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wg.ID))
+	builder.WriteString("name=")
+	builder.WriteString(wg.Name)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wg.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WithGroupsSlice is a parsable slice of WithGroups.
+type WithGroupsSlice []*WithGroups
+
+func (wg WithGroupsSlice) config(cfg config) {
+	for _i := range wg {
+		wg[_i].config = cfg
+	}
+}
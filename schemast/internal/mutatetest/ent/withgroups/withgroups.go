@@ -0,0 +1,33 @@
+// Code generated by ent, DO NOT EDIT.
+
+package withgroups
+
+const (
+	// Label holds the string label denoting the withgroups type in the database.
+	Label = "with_groups"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the withgroups in the database.
+	Table = "with_groups"
+)
+
+// Columns holds all SQL columns for withgroups fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withgroups"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithGroupsCreate is the builder for creating a WithGroups entity.
+type WithGroupsCreate struct {
+	config
+	mutation *WithGroupsMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (wgc *WithGroupsCreate) SetName(s string) *WithGroupsCreate {
+	wgc.mutation.SetName(s)
+	return wgc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wgc *WithGroupsCreate) SetCreatedAt(t time.Time) *WithGroupsCreate {
+	wgc.mutation.SetCreatedAt(t)
+	return wgc
+}
+
+// Mutation returns the WithGroupsMutation object of the builder.
+func (wgc *WithGroupsCreate) Mutation() *WithGroupsMutation {
+	return wgc.mutation
+}
+
+// Save creates the WithGroups in the database.
+func (wgc *WithGroupsCreate) Save(ctx context.Context) (*WithGroups, error) {
+	var (
+		err  error
+		node *WithGroups
+	)
+	if len(wgc.hooks) == 0 {
+		if err = wgc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wgc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithGroupsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wgc.check(); err != nil {
+				return nil, err
+			}
+			wgc.mutation = mutation
+			if node, err = wgc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wgc.hooks) - 1; i >= 0; i-- {
+			if wgc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wgc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wgc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WithGroups)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WithGroupsMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wgc *WithGroupsCreate) SaveX(ctx context.Context) *WithGroups {
+	v, err := wgc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wgc *WithGroupsCreate) Exec(ctx context.Context) error {
+	_, err := wgc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wgc *WithGroupsCreate) ExecX(ctx context.Context) {
+	if err := wgc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wgc *WithGroupsCreate) check() error {
+	if _, ok := wgc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WithGroups.name"`)}
+	}
+	if _, ok := wgc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WithGroups.created_at"`)}
+	}
+	return nil
+}
+
+func (wgc *WithGroupsCreate) sqlSave(ctx context.Context) (*WithGroups, error) {
+	_node, _spec := wgc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wgc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wgc *WithGroupsCreate) createSpec() (*WithGroups, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WithGroups{config: wgc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: withgroups.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withgroups.FieldID,
+			},
+		}
+	)
+	if value, ok := wgc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withgroups.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := wgc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: withgroups.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// WithGroupsCreateBulk is the builder for creating many WithGroups entities in bulk.
+type WithGroupsCreateBulk struct {
+	config
+	builders []*WithGroupsCreate
+}
+
+// Save creates the WithGroups entities in the database.
+func (wgcb *WithGroupsCreateBulk) Save(ctx context.Context) ([]*WithGroups, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wgcb.builders))
+	nodes := make([]*WithGroups, len(wgcb.builders))
+	mutators := make([]Mutator, len(wgcb.builders))
+	for i := range wgcb.builders {
+		func(i int, root context.Context) {
+			builder := wgcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WithGroupsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wgcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wgcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wgcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wgcb *WithGroupsCreateBulk) SaveX(ctx context.Context) []*WithGroups {
+	v, err := wgcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wgcb *WithGroupsCreateBulk) Exec(ctx context.Context) error {
+	_, err := wgcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wgcb *WithGroupsCreateBulk) ExecX(ctx context.Context) {
+	if err := wgcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withgroups"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithGroupsDelete is the builder for deleting a WithGroups entity.
+type WithGroupsDelete struct {
+	config
+	hooks    []Hook
+	mutation *WithGroupsMutation
+}
+
+// Where appends a list predicates to the WithGroupsDelete builder.
+func (wgd *WithGroupsDelete) Where(ps ...predicate.WithGroups) *WithGroupsDelete {
+	wgd.mutation.Where(ps...)
+	return wgd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wgd *WithGroupsDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wgd.hooks) == 0 {
+		affected, err = wgd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithGroupsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wgd.mutation = mutation
+			affected, err = wgd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wgd.hooks) - 1; i >= 0; i-- {
+			if wgd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wgd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wgd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wgd *WithGroupsDelete) ExecX(ctx context.Context) int {
+	n, err := wgd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wgd *WithGroupsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: withgroups.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withgroups.FieldID,
+			},
+		},
+	}
+	if ps := wgd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wgd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// WithGroupsDeleteOne is the builder for deleting a single WithGroups entity.
+type WithGroupsDeleteOne struct {
+	wgd *WithGroupsDelete
+}
+
+// Exec executes the deletion query.
+func (wgdo *WithGroupsDeleteOne) Exec(ctx context.Context) error {
+	n, err := wgdo.wgd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{withgroups.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wgdo *WithGroupsDeleteOne) ExecX(ctx context.Context) {
+	wgdo.wgd.ExecX(ctx)
+}
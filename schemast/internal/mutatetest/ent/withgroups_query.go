@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withgroups"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithGroupsQuery is the builder for querying WithGroups entities.
+type WithGroupsQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WithGroups
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WithGroupsQuery builder.
+func (wgq *WithGroupsQuery) Where(ps ...predicate.WithGroups) *WithGroupsQuery {
+	wgq.predicates = append(wgq.predicates, ps...)
+	return wgq
+}
+
+// Limit adds a limit step to the query.
+func (wgq *WithGroupsQuery) Limit(limit int) *WithGroupsQuery {
+	wgq.limit = &limit
+	return wgq
+}
+
+// Offset adds an offset step to the query.
+func (wgq *WithGroupsQuery) Offset(offset int) *WithGroupsQuery {
+	wgq.offset = &offset
+	return wgq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wgq *WithGroupsQuery) Unique(unique bool) *WithGroupsQuery {
+	wgq.unique = &unique
+	return wgq
+}
+
+// Order adds an order step to the query.
+func (wgq *WithGroupsQuery) Order(o ...OrderFunc) *WithGroupsQuery {
+	wgq.order = append(wgq.order, o...)
+	return wgq
+}
+
+// First returns the first WithGroups entity from the query.
+// Returns a *NotFoundError when no WithGroups was found.
+func (wgq *WithGroupsQuery) First(ctx context.Context) (*WithGroups, error) {
+	nodes, err := wgq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{withgroups.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wgq *WithGroupsQuery) FirstX(ctx context.Context) *WithGroups {
+	node, err := wgq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WithGroups ID from the query.
+// Returns a *NotFoundError when no WithGroups ID was found.
+func (wgq *WithGroupsQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wgq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{withgroups.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wgq *WithGroupsQuery) FirstIDX(ctx context.Context) int {
+	id, err := wgq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WithGroups entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WithGroups entity is found.
+// Returns a *NotFoundError when no WithGroups entities are found.
+func (wgq *WithGroupsQuery) Only(ctx context.Context) (*WithGroups, error) {
+	nodes, err := wgq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{withgroups.Label}
+	default:
+		return nil, &NotSingularError{withgroups.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wgq *WithGroupsQuery) OnlyX(ctx context.Context) *WithGroups {
+	node, err := wgq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WithGroups ID in the query.
+// Returns a *NotSingularError when more than one WithGroups ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wgq *WithGroupsQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wgq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{withgroups.Label}
+	default:
+		err = &NotSingularError{withgroups.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wgq *WithGroupsQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wgq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WithGroupsSlice.
+func (wgq *WithGroupsQuery) All(ctx context.Context) ([]*WithGroups, error) {
+	if err := wgq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wgq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wgq *WithGroupsQuery) AllX(ctx context.Context) []*WithGroups {
+	nodes, err := wgq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WithGroups IDs.
+func (wgq *WithGroupsQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wgq.Select(withgroups.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wgq *WithGroupsQuery) IDsX(ctx context.Context) []int {
+	ids, err := wgq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wgq *WithGroupsQuery) Count(ctx context.Context) (int, error) {
+	if err := wgq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wgq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wgq *WithGroupsQuery) CountX(ctx context.Context) int {
+	count, err := wgq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wgq *WithGroupsQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wgq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wgq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wgq *WithGroupsQuery) ExistX(ctx context.Context) bool {
+	exist, err := wgq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WithGroupsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wgq *WithGroupsQuery) Clone() *WithGroupsQuery {
+	if wgq == nil {
+		return nil
+	}
+	return &WithGroupsQuery{
+		config:     wgq.config,
+		limit:      wgq.limit,
+		offset:     wgq.offset,
+		order:      append([]OrderFunc{}, wgq.order...),
+		predicates: append([]predicate.WithGroups{}, wgq.predicates...),
+		// clone intermediate query.
+		sql:    wgq.sql.Clone(),
+		path:   wgq.path,
+		unique: wgq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WithGroups.Query().
+//		GroupBy(withgroups.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wgq *WithGroupsQuery) GroupBy(field string, fields ...string) *WithGroupsGroupBy {
+	grbuild := &WithGroupsGroupBy{config: wgq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wgq.sqlQuery(ctx), nil
+	}
+	grbuild.label = withgroups.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.WithGroups.Query().
+//		Select(withgroups.FieldName).
+//		Scan(ctx, &v)
+func (wgq *WithGroupsQuery) Select(fields ...string) *WithGroupsSelect {
+	wgq.fields = append(wgq.fields, fields...)
+	selbuild := &WithGroupsSelect{WithGroupsQuery: wgq}
+	selbuild.label = withgroups.Label
+	selbuild.flds, selbuild.scan = &wgq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (wgq *WithGroupsQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wgq.fields {
+		if !withgroups.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wgq.path != nil {
+		prev, err := wgq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wgq.sql = prev
+	}
+	return nil
+}
+
+func (wgq *WithGroupsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WithGroups, error) {
+	var (
+		nodes = []*WithGroups{}
+		_spec = wgq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WithGroups).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WithGroups{config: wgq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wgq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wgq *WithGroupsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wgq.querySpec()
+	_spec.Node.Columns = wgq.fields
+	if len(wgq.fields) > 0 {
+		_spec.Unique = wgq.unique != nil && *wgq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wgq.driver, _spec)
+}
+
+func (wgq *WithGroupsQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wgq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wgq *WithGroupsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withgroups.Table,
+			Columns: withgroups.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withgroups.FieldID,
+			},
+		},
+		From:   wgq.sql,
+		Unique: true,
+	}
+	if unique := wgq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wgq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withgroups.FieldID)
+		for i := range fields {
+			if fields[i] != withgroups.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wgq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wgq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wgq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wgq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wgq *WithGroupsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wgq.driver.Dialect())
+	t1 := builder.Table(withgroups.Table)
+	columns := wgq.fields
+	if len(columns) == 0 {
+		columns = withgroups.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wgq.sql != nil {
+		selector = wgq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wgq.unique != nil && *wgq.unique {
+		selector.Distinct()
+	}
+	for _, p := range wgq.predicates {
+		p(selector)
+	}
+	for _, p := range wgq.order {
+		p(selector)
+	}
+	if offset := wgq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wgq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithGroupsGroupBy is the group-by builder for WithGroups entities.
+type WithGroupsGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wggb *WithGroupsGroupBy) Aggregate(fns ...AggregateFunc) *WithGroupsGroupBy {
+	wggb.fns = append(wggb.fns, fns...)
+	return wggb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wggb *WithGroupsGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := wggb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wggb.sql = query
+	return wggb.sqlScan(ctx, v)
+}
+
+func (wggb *WithGroupsGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range wggb.fields {
+		if !withgroups.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wggb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wggb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wggb *WithGroupsGroupBy) sqlQuery() *sql.Selector {
+	selector := wggb.sql.Select()
+	aggregation := make([]string, 0, len(wggb.fns))
+	for _, fn := range wggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wggb.fields)+len(wggb.fns))
+		for _, f := range wggb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wggb.fields...)...)
+}
+
+// WithGroupsSelect is the builder for selecting fields of WithGroups entities.
+type WithGroupsSelect struct {
+	*WithGroupsQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wgs *WithGroupsSelect) Scan(ctx context.Context, v any) error {
+	if err := wgs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wgs.sql = wgs.WithGroupsQuery.sqlQuery(ctx)
+	return wgs.sqlScan(ctx, v)
+}
+
+func (wgs *WithGroupsSelect) sqlScan(ctx context.Context, v any) error {
+	rows := &sql.Rows{}
+	query, args := wgs.sql.Query()
+	if err := wgs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
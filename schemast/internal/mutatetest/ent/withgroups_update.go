@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withgroups"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithGroupsUpdate is the builder for updating WithGroups entities.
+type WithGroupsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WithGroupsMutation
+}
+
+// Where appends a list predicates to the WithGroupsUpdate builder.
+func (wgu *WithGroupsUpdate) Where(ps ...predicate.WithGroups) *WithGroupsUpdate {
+	wgu.mutation.Where(ps...)
+	return wgu
+}
+
+// SetName sets the "name" field.
+func (wgu *WithGroupsUpdate) SetName(s string) *WithGroupsUpdate {
+	wgu.mutation.SetName(s)
+	return wgu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wgu *WithGroupsUpdate) SetCreatedAt(t time.Time) *WithGroupsUpdate {
+	wgu.mutation.SetCreatedAt(t)
+	return wgu
+}
+
+// Mutation returns the WithGroupsMutation object of the builder.
+func (wgu *WithGroupsUpdate) Mutation() *WithGroupsMutation {
+	return wgu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wgu *WithGroupsUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wgu.hooks) == 0 {
+		affected, err = wgu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithGroupsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wgu.mutation = mutation
+			affected, err = wgu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wgu.hooks) - 1; i >= 0; i-- {
+			if wgu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wgu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wgu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wgu *WithGroupsUpdate) SaveX(ctx context.Context) int {
+	affected, err := wgu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wgu *WithGroupsUpdate) Exec(ctx context.Context) error {
+	_, err := wgu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wgu *WithGroupsUpdate) ExecX(ctx context.Context) {
+	if err := wgu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wgu *WithGroupsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withgroups.Table,
+			Columns: withgroups.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withgroups.FieldID,
+			},
+		},
+	}
+	if ps := wgu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wgu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withgroups.FieldName,
+		})
+	}
+	if value, ok := wgu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: withgroups.FieldCreatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wgu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withgroups.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WithGroupsUpdateOne is the builder for updating a single WithGroups entity.
+type WithGroupsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WithGroupsMutation
+}
+
+// SetName sets the "name" field.
+func (wguo *WithGroupsUpdateOne) SetName(s string) *WithGroupsUpdateOne {
+	wguo.mutation.SetName(s)
+	return wguo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wguo *WithGroupsUpdateOne) SetCreatedAt(t time.Time) *WithGroupsUpdateOne {
+	wguo.mutation.SetCreatedAt(t)
+	return wguo
+}
+
+// Mutation returns the WithGroupsMutation object of the builder.
+func (wguo *WithGroupsUpdateOne) Mutation() *WithGroupsMutation {
+	return wguo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wguo *WithGroupsUpdateOne) Select(field string, fields ...string) *WithGroupsUpdateOne {
+	wguo.fields = append([]string{field}, fields...)
+	return wguo
+}
+
+// Save executes the query and returns the updated WithGroups entity.
+func (wguo *WithGroupsUpdateOne) Save(ctx context.Context) (*WithGroups, error) {
+	var (
+		err  error
+		node *WithGroups
+	)
+	if len(wguo.hooks) == 0 {
+		node, err = wguo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithGroupsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wguo.mutation = mutation
+			node, err = wguo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wguo.hooks) - 1; i >= 0; i-- {
+			if wguo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wguo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wguo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WithGroups)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WithGroupsMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wguo *WithGroupsUpdateOne) SaveX(ctx context.Context) *WithGroups {
+	node, err := wguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wguo *WithGroupsUpdateOne) Exec(ctx context.Context) error {
+	_, err := wguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wguo *WithGroupsUpdateOne) ExecX(ctx context.Context) {
+	if err := wguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wguo *WithGroupsUpdateOne) sqlSave(ctx context.Context) (_node *WithGroups, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withgroups.Table,
+			Columns: withgroups.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withgroups.FieldID,
+			},
+		},
+	}
+	id, ok := wguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WithGroups.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withgroups.FieldID)
+		for _, f := range fields {
+			if !withgroups.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != withgroups.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wguo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withgroups.FieldName,
+		})
+	}
+	if value, ok := wguo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: withgroups.FieldCreatedAt,
+		})
+	}
+	_node = &WithGroups{config: wguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withgroups.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
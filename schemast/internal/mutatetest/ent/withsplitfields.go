@@ -0,0 +1,99 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withsplitfields"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WithSplitFields is the model entity for the WithSplitFields schema.
+type WithSplitFields struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Existing holds the value of the "existing" field.
+	Existing string `json:"existing,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WithSplitFields) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case withsplitfields.FieldID:
+			values[i] = new(sql.NullInt64)
+		case withsplitfields.FieldExisting:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WithSplitFields", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WithSplitFields fields.
+func (wsf *WithSplitFields) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case withsplitfields.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wsf.ID = int(value.Int64)
+		case withsplitfields.FieldExisting:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field existing", values[i])
+			} else if value.Valid {
+				wsf.Existing = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this WithSplitFields.
+// Note that you need to call WithSplitFields.Unwrap() before calling this method if this WithSplitFields
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wsf *WithSplitFields) Update() *WithSplitFieldsUpdateOne {
+	return (&WithSplitFieldsClient{config: wsf.config}).UpdateOne(wsf)
+}
+
+// Unwrap unwraps the WithSplitFields entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wsf *WithSplitFields) Unwrap() *WithSplitFields {
+	_tx, ok := wsf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WithSplitFields is not a transactional entity")
+	}
+	wsf.config.driver = _tx.drv
+	return wsf
+}
+
+// String implements the fmt.Stringer.
+func (wsf *WithSplitFields) String() string {
+	var builder strings.Builder
+	builder.WriteString("WithSplitFields(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wsf.ID))
+	builder.WriteString("existing=")
+	builder.WriteString(wsf.Existing)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WithSplitFieldsSlice is a parsable slice of WithSplitFields.
+type WithSplitFieldsSlice []*WithSplitFields
+
+func (wsf WithSplitFieldsSlice) config(cfg config) {
+	for _i := range wsf {
+		wsf[_i].config = cfg
+	}
+}
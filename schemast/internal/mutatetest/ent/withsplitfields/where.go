@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package withsplitfields
+
+import (
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Existing applies equality check predicate on the "existing" field. It's identical to ExistingEQ.
+func Existing(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingEQ applies the EQ predicate on the "existing" field.
+func ExistingEQ(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingNEQ applies the NEQ predicate on the "existing" field.
+func ExistingNEQ(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingIn applies the In predicate on the "existing" field.
+func ExistingIn(vs ...string) predicate.WithSplitFields {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldExisting), v...))
+	})
+}
+
+// ExistingNotIn applies the NotIn predicate on the "existing" field.
+func ExistingNotIn(vs ...string) predicate.WithSplitFields {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldExisting), v...))
+	})
+}
+
+// ExistingGT applies the GT predicate on the "existing" field.
+func ExistingGT(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingGTE applies the GTE predicate on the "existing" field.
+func ExistingGTE(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingLT applies the LT predicate on the "existing" field.
+func ExistingLT(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingLTE applies the LTE predicate on the "existing" field.
+func ExistingLTE(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingContains applies the Contains predicate on the "existing" field.
+func ExistingContains(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingHasPrefix applies the HasPrefix predicate on the "existing" field.
+func ExistingHasPrefix(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingHasSuffix applies the HasSuffix predicate on the "existing" field.
+func ExistingHasSuffix(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingEqualFold applies the EqualFold predicate on the "existing" field.
+func ExistingEqualFold(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldExisting), v))
+	})
+}
+
+// ExistingContainsFold applies the ContainsFold predicate on the "existing" field.
+func ExistingContainsFold(v string) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldExisting), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WithSplitFields) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WithSplitFields) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WithSplitFields) predicate.WithSplitFields {
+	return predicate.WithSplitFields(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
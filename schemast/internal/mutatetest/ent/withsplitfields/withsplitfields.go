@@ -0,0 +1,30 @@
+// Code generated by ent, DO NOT EDIT.
+
+package withsplitfields
+
+const (
+	// Label holds the string label denoting the withsplitfields type in the database.
+	Label = "with_split_fields"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldExisting holds the string denoting the existing field in the database.
+	FieldExisting = "existing"
+	// Table holds the table name of the withsplitfields in the database.
+	Table = "with_split_fields"
+)
+
+// Columns holds all SQL columns for withsplitfields fields.
+var Columns = []string{
+	FieldID,
+	FieldExisting,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withsplitfields"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithSplitFieldsCreate is the builder for creating a WithSplitFields entity.
+type WithSplitFieldsCreate struct {
+	config
+	mutation *WithSplitFieldsMutation
+	hooks    []Hook
+}
+
+// SetExisting sets the "existing" field.
+func (wsfc *WithSplitFieldsCreate) SetExisting(s string) *WithSplitFieldsCreate {
+	wsfc.mutation.SetExisting(s)
+	return wsfc
+}
+
+// Mutation returns the WithSplitFieldsMutation object of the builder.
+func (wsfc *WithSplitFieldsCreate) Mutation() *WithSplitFieldsMutation {
+	return wsfc.mutation
+}
+
+// Save creates the WithSplitFields in the database.
+func (wsfc *WithSplitFieldsCreate) Save(ctx context.Context) (*WithSplitFields, error) {
+	var (
+		err  error
+		node *WithSplitFields
+	)
+	if len(wsfc.hooks) == 0 {
+		if err = wsfc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wsfc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithSplitFieldsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wsfc.check(); err != nil {
+				return nil, err
+			}
+			wsfc.mutation = mutation
+			if node, err = wsfc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wsfc.hooks) - 1; i >= 0; i-- {
+			if wsfc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsfc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wsfc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WithSplitFields)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WithSplitFieldsMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wsfc *WithSplitFieldsCreate) SaveX(ctx context.Context) *WithSplitFields {
+	v, err := wsfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsfc *WithSplitFieldsCreate) Exec(ctx context.Context) error {
+	_, err := wsfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsfc *WithSplitFieldsCreate) ExecX(ctx context.Context) {
+	if err := wsfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsfc *WithSplitFieldsCreate) check() error {
+	if _, ok := wsfc.mutation.Existing(); !ok {
+		return &ValidationError{Name: "existing", err: errors.New(`ent: missing required field "WithSplitFields.existing"`)}
+	}
+	return nil
+}
+
+func (wsfc *WithSplitFieldsCreate) sqlSave(ctx context.Context) (*WithSplitFields, error) {
+	_node, _spec := wsfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wsfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wsfc *WithSplitFieldsCreate) createSpec() (*WithSplitFields, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WithSplitFields{config: wsfc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: withsplitfields.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withsplitfields.FieldID,
+			},
+		}
+	)
+	if value, ok := wsfc.mutation.Existing(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withsplitfields.FieldExisting,
+		})
+		_node.Existing = value
+	}
+	return _node, _spec
+}
+
+// WithSplitFieldsCreateBulk is the builder for creating many WithSplitFields entities in bulk.
+type WithSplitFieldsCreateBulk struct {
+	config
+	builders []*WithSplitFieldsCreate
+}
+
+// Save creates the WithSplitFields entities in the database.
+func (wsfcb *WithSplitFieldsCreateBulk) Save(ctx context.Context) ([]*WithSplitFields, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wsfcb.builders))
+	nodes := make([]*WithSplitFields, len(wsfcb.builders))
+	mutators := make([]Mutator, len(wsfcb.builders))
+	for i := range wsfcb.builders {
+		func(i int, root context.Context) {
+			builder := wsfcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WithSplitFieldsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wsfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wsfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wsfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsfcb *WithSplitFieldsCreateBulk) SaveX(ctx context.Context) []*WithSplitFields {
+	v, err := wsfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsfcb *WithSplitFieldsCreateBulk) Exec(ctx context.Context) error {
+	_, err := wsfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsfcb *WithSplitFieldsCreateBulk) ExecX(ctx context.Context) {
+	if err := wsfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
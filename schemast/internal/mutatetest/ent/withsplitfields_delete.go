@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withsplitfields"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithSplitFieldsDelete is the builder for deleting a WithSplitFields entity.
+type WithSplitFieldsDelete struct {
+	config
+	hooks    []Hook
+	mutation *WithSplitFieldsMutation
+}
+
+// Where appends a list predicates to the WithSplitFieldsDelete builder.
+func (wsfd *WithSplitFieldsDelete) Where(ps ...predicate.WithSplitFields) *WithSplitFieldsDelete {
+	wsfd.mutation.Where(ps...)
+	return wsfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wsfd *WithSplitFieldsDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wsfd.hooks) == 0 {
+		affected, err = wsfd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithSplitFieldsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wsfd.mutation = mutation
+			affected, err = wsfd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wsfd.hooks) - 1; i >= 0; i-- {
+			if wsfd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsfd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wsfd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsfd *WithSplitFieldsDelete) ExecX(ctx context.Context) int {
+	n, err := wsfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wsfd *WithSplitFieldsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: withsplitfields.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withsplitfields.FieldID,
+			},
+		},
+	}
+	if ps := wsfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wsfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// WithSplitFieldsDeleteOne is the builder for deleting a single WithSplitFields entity.
+type WithSplitFieldsDeleteOne struct {
+	wsfd *WithSplitFieldsDelete
+}
+
+// Exec executes the deletion query.
+func (wsfdo *WithSplitFieldsDeleteOne) Exec(ctx context.Context) error {
+	n, err := wsfdo.wsfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{withsplitfields.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsfdo *WithSplitFieldsDeleteOne) ExecX(ctx context.Context) {
+	wsfdo.wsfd.ExecX(ctx)
+}
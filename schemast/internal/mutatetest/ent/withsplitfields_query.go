@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withsplitfields"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithSplitFieldsQuery is the builder for querying WithSplitFields entities.
+type WithSplitFieldsQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WithSplitFields
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WithSplitFieldsQuery builder.
+func (wsfq *WithSplitFieldsQuery) Where(ps ...predicate.WithSplitFields) *WithSplitFieldsQuery {
+	wsfq.predicates = append(wsfq.predicates, ps...)
+	return wsfq
+}
+
+// Limit adds a limit step to the query.
+func (wsfq *WithSplitFieldsQuery) Limit(limit int) *WithSplitFieldsQuery {
+	wsfq.limit = &limit
+	return wsfq
+}
+
+// Offset adds an offset step to the query.
+func (wsfq *WithSplitFieldsQuery) Offset(offset int) *WithSplitFieldsQuery {
+	wsfq.offset = &offset
+	return wsfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wsfq *WithSplitFieldsQuery) Unique(unique bool) *WithSplitFieldsQuery {
+	wsfq.unique = &unique
+	return wsfq
+}
+
+// Order adds an order step to the query.
+func (wsfq *WithSplitFieldsQuery) Order(o ...OrderFunc) *WithSplitFieldsQuery {
+	wsfq.order = append(wsfq.order, o...)
+	return wsfq
+}
+
+// First returns the first WithSplitFields entity from the query.
+// Returns a *NotFoundError when no WithSplitFields was found.
+func (wsfq *WithSplitFieldsQuery) First(ctx context.Context) (*WithSplitFields, error) {
+	nodes, err := wsfq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{withsplitfields.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wsfq *WithSplitFieldsQuery) FirstX(ctx context.Context) *WithSplitFields {
+	node, err := wsfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WithSplitFields ID from the query.
+// Returns a *NotFoundError when no WithSplitFields ID was found.
+func (wsfq *WithSplitFieldsQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wsfq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{withsplitfields.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wsfq *WithSplitFieldsQuery) FirstIDX(ctx context.Context) int {
+	id, err := wsfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WithSplitFields entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WithSplitFields entity is found.
+// Returns a *NotFoundError when no WithSplitFields entities are found.
+func (wsfq *WithSplitFieldsQuery) Only(ctx context.Context) (*WithSplitFields, error) {
+	nodes, err := wsfq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{withsplitfields.Label}
+	default:
+		return nil, &NotSingularError{withsplitfields.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wsfq *WithSplitFieldsQuery) OnlyX(ctx context.Context) *WithSplitFields {
+	node, err := wsfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WithSplitFields ID in the query.
+// Returns a *NotSingularError when more than one WithSplitFields ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wsfq *WithSplitFieldsQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wsfq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{withsplitfields.Label}
+	default:
+		err = &NotSingularError{withsplitfields.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wsfq *WithSplitFieldsQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wsfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WithSplitFieldsSlice.
+func (wsfq *WithSplitFieldsQuery) All(ctx context.Context) ([]*WithSplitFields, error) {
+	if err := wsfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wsfq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wsfq *WithSplitFieldsQuery) AllX(ctx context.Context) []*WithSplitFields {
+	nodes, err := wsfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WithSplitFields IDs.
+func (wsfq *WithSplitFieldsQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wsfq.Select(withsplitfields.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wsfq *WithSplitFieldsQuery) IDsX(ctx context.Context) []int {
+	ids, err := wsfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wsfq *WithSplitFieldsQuery) Count(ctx context.Context) (int, error) {
+	if err := wsfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wsfq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wsfq *WithSplitFieldsQuery) CountX(ctx context.Context) int {
+	count, err := wsfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wsfq *WithSplitFieldsQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wsfq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wsfq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wsfq *WithSplitFieldsQuery) ExistX(ctx context.Context) bool {
+	exist, err := wsfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WithSplitFieldsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wsfq *WithSplitFieldsQuery) Clone() *WithSplitFieldsQuery {
+	if wsfq == nil {
+		return nil
+	}
+	return &WithSplitFieldsQuery{
+		config:     wsfq.config,
+		limit:      wsfq.limit,
+		offset:     wsfq.offset,
+		order:      append([]OrderFunc{}, wsfq.order...),
+		predicates: append([]predicate.WithSplitFields{}, wsfq.predicates...),
+		// clone intermediate query.
+		sql:    wsfq.sql.Clone(),
+		path:   wsfq.path,
+		unique: wsfq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Existing string `json:"existing,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WithSplitFields.Query().
+//		GroupBy(withsplitfields.FieldExisting).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wsfq *WithSplitFieldsQuery) GroupBy(field string, fields ...string) *WithSplitFieldsGroupBy {
+	grbuild := &WithSplitFieldsGroupBy{config: wsfq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wsfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wsfq.sqlQuery(ctx), nil
+	}
+	grbuild.label = withsplitfields.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Existing string `json:"existing,omitempty"`
+//	}
+//
+//	client.WithSplitFields.Query().
+//		Select(withsplitfields.FieldExisting).
+//		Scan(ctx, &v)
+func (wsfq *WithSplitFieldsQuery) Select(fields ...string) *WithSplitFieldsSelect {
+	wsfq.fields = append(wsfq.fields, fields...)
+	selbuild := &WithSplitFieldsSelect{WithSplitFieldsQuery: wsfq}
+	selbuild.label = withsplitfields.Label
+	selbuild.flds, selbuild.scan = &wsfq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (wsfq *WithSplitFieldsQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wsfq.fields {
+		if !withsplitfields.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wsfq.path != nil {
+		prev, err := wsfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wsfq.sql = prev
+	}
+	return nil
+}
+
+func (wsfq *WithSplitFieldsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WithSplitFields, error) {
+	var (
+		nodes = []*WithSplitFields{}
+		_spec = wsfq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WithSplitFields).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WithSplitFields{config: wsfq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wsfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wsfq *WithSplitFieldsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wsfq.querySpec()
+	_spec.Node.Columns = wsfq.fields
+	if len(wsfq.fields) > 0 {
+		_spec.Unique = wsfq.unique != nil && *wsfq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wsfq.driver, _spec)
+}
+
+func (wsfq *WithSplitFieldsQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wsfq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wsfq *WithSplitFieldsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withsplitfields.Table,
+			Columns: withsplitfields.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withsplitfields.FieldID,
+			},
+		},
+		From:   wsfq.sql,
+		Unique: true,
+	}
+	if unique := wsfq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wsfq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withsplitfields.FieldID)
+		for i := range fields {
+			if fields[i] != withsplitfields.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wsfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wsfq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wsfq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wsfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wsfq *WithSplitFieldsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wsfq.driver.Dialect())
+	t1 := builder.Table(withsplitfields.Table)
+	columns := wsfq.fields
+	if len(columns) == 0 {
+		columns = withsplitfields.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wsfq.sql != nil {
+		selector = wsfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wsfq.unique != nil && *wsfq.unique {
+		selector.Distinct()
+	}
+	for _, p := range wsfq.predicates {
+		p(selector)
+	}
+	for _, p := range wsfq.order {
+		p(selector)
+	}
+	if offset := wsfq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wsfq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithSplitFieldsGroupBy is the group-by builder for WithSplitFields entities.
+type WithSplitFieldsGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wsfgb *WithSplitFieldsGroupBy) Aggregate(fns ...AggregateFunc) *WithSplitFieldsGroupBy {
+	wsfgb.fns = append(wsfgb.fns, fns...)
+	return wsfgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wsfgb *WithSplitFieldsGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := wsfgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wsfgb.sql = query
+	return wsfgb.sqlScan(ctx, v)
+}
+
+func (wsfgb *WithSplitFieldsGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range wsfgb.fields {
+		if !withsplitfields.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wsfgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wsfgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wsfgb *WithSplitFieldsGroupBy) sqlQuery() *sql.Selector {
+	selector := wsfgb.sql.Select()
+	aggregation := make([]string, 0, len(wsfgb.fns))
+	for _, fn := range wsfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wsfgb.fields)+len(wsfgb.fns))
+		for _, f := range wsfgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wsfgb.fields...)...)
+}
+
+// WithSplitFieldsSelect is the builder for selecting fields of WithSplitFields entities.
+type WithSplitFieldsSelect struct {
+	*WithSplitFieldsQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wsfs *WithSplitFieldsSelect) Scan(ctx context.Context, v any) error {
+	if err := wsfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wsfs.sql = wsfs.WithSplitFieldsQuery.sqlQuery(ctx)
+	return wsfs.sqlScan(ctx, v)
+}
+
+func (wsfs *WithSplitFieldsSelect) sqlScan(ctx context.Context, v any) error {
+	rows := &sql.Rows{}
+	query, args := wsfs.sql.Query()
+	if err := wsfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
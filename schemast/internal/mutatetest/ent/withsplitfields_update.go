@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withsplitfields"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithSplitFieldsUpdate is the builder for updating WithSplitFields entities.
+type WithSplitFieldsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WithSplitFieldsMutation
+}
+
+// Where appends a list predicates to the WithSplitFieldsUpdate builder.
+func (wsfu *WithSplitFieldsUpdate) Where(ps ...predicate.WithSplitFields) *WithSplitFieldsUpdate {
+	wsfu.mutation.Where(ps...)
+	return wsfu
+}
+
+// SetExisting sets the "existing" field.
+func (wsfu *WithSplitFieldsUpdate) SetExisting(s string) *WithSplitFieldsUpdate {
+	wsfu.mutation.SetExisting(s)
+	return wsfu
+}
+
+// Mutation returns the WithSplitFieldsMutation object of the builder.
+func (wsfu *WithSplitFieldsUpdate) Mutation() *WithSplitFieldsMutation {
+	return wsfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsfu *WithSplitFieldsUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wsfu.hooks) == 0 {
+		affected, err = wsfu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithSplitFieldsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wsfu.mutation = mutation
+			affected, err = wsfu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wsfu.hooks) - 1; i >= 0; i-- {
+			if wsfu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsfu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wsfu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsfu *WithSplitFieldsUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsfu *WithSplitFieldsUpdate) Exec(ctx context.Context) error {
+	_, err := wsfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsfu *WithSplitFieldsUpdate) ExecX(ctx context.Context) {
+	if err := wsfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wsfu *WithSplitFieldsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withsplitfields.Table,
+			Columns: withsplitfields.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withsplitfields.FieldID,
+			},
+		},
+	}
+	if ps := wsfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsfu.mutation.Existing(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withsplitfields.FieldExisting,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wsfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withsplitfields.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WithSplitFieldsUpdateOne is the builder for updating a single WithSplitFields entity.
+type WithSplitFieldsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WithSplitFieldsMutation
+}
+
+// SetExisting sets the "existing" field.
+func (wsfuo *WithSplitFieldsUpdateOne) SetExisting(s string) *WithSplitFieldsUpdateOne {
+	wsfuo.mutation.SetExisting(s)
+	return wsfuo
+}
+
+// Mutation returns the WithSplitFieldsMutation object of the builder.
+func (wsfuo *WithSplitFieldsUpdateOne) Mutation() *WithSplitFieldsMutation {
+	return wsfuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsfuo *WithSplitFieldsUpdateOne) Select(field string, fields ...string) *WithSplitFieldsUpdateOne {
+	wsfuo.fields = append([]string{field}, fields...)
+	return wsfuo
+}
+
+// Save executes the query and returns the updated WithSplitFields entity.
+func (wsfuo *WithSplitFieldsUpdateOne) Save(ctx context.Context) (*WithSplitFields, error) {
+	var (
+		err  error
+		node *WithSplitFields
+	)
+	if len(wsfuo.hooks) == 0 {
+		node, err = wsfuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithSplitFieldsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wsfuo.mutation = mutation
+			node, err = wsfuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wsfuo.hooks) - 1; i >= 0; i-- {
+			if wsfuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsfuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wsfuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WithSplitFields)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WithSplitFieldsMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsfuo *WithSplitFieldsUpdateOne) SaveX(ctx context.Context) *WithSplitFields {
+	node, err := wsfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsfuo *WithSplitFieldsUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsfuo *WithSplitFieldsUpdateOne) ExecX(ctx context.Context) {
+	if err := wsfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wsfuo *WithSplitFieldsUpdateOne) sqlSave(ctx context.Context) (_node *WithSplitFields, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withsplitfields.Table,
+			Columns: withsplitfields.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withsplitfields.FieldID,
+			},
+		},
+	}
+	id, ok := wsfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WithSplitFields.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withsplitfields.FieldID)
+		for _, f := range fields {
+			if !withsplitfields.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != withsplitfields.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsfuo.mutation.Existing(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withsplitfields.FieldExisting,
+		})
+	}
+	_node = &WithSplitFields{config: wsfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withsplitfields.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
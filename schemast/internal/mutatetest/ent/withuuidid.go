@@ -0,0 +1,100 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withuuidid"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// WithUUIDID is the model entity for the WithUUIDID schema.
+type WithUUIDID struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WithUUIDID) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case withuuidid.FieldName:
+			values[i] = new(sql.NullString)
+		case withuuidid.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WithUUIDID", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WithUUIDID fields.
+func (wu *WithUUIDID) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case withuuidid.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wu.ID = *value
+			}
+		case withuuidid.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				wu.Name = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this WithUUIDID.
+// Note that you need to call WithUUIDID.Unwrap() before calling this method if this WithUUIDID
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wu *WithUUIDID) Update() *WithUUIDIDUpdateOne {
+	return (&WithUUIDIDClient{config: wu.config}).UpdateOne(wu)
+}
+
+// Unwrap unwraps the WithUUIDID entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wu *WithUUIDID) Unwrap() *WithUUIDID {
+	_tx, ok := wu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WithUUIDID is not a transactional entity")
+	}
+	wu.config.driver = _tx.drv
+	return wu
+}
+
+// String implements the fmt.Stringer.
+func (wu *WithUUIDID) String() string {
+	var builder strings.Builder
+	builder.WriteString("WithUUIDID(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wu.ID))
+	builder.WriteString("name=")
+	builder.WriteString(wu.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WithUUIDIDs is a parsable slice of WithUUIDID.
+type WithUUIDIDs []*WithUUIDID
+
+func (wu WithUUIDIDs) config(cfg config) {
+	for _i := range wu {
+		wu[_i].config = cfg
+	}
+}
@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package withuuidid
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the withuuidid type in the database.
+	Label = "with_uuidid"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// Table holds the table name of the withuuidid in the database.
+	Table = "with_uuidi_ds"
+)
+
+// Columns holds all SQL columns for withuuidid fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
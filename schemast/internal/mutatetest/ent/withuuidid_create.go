@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withuuidid"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WithUUIDIDCreate is the builder for creating a WithUUIDID entity.
+type WithUUIDIDCreate struct {
+	config
+	mutation *WithUUIDIDMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (wuc *WithUUIDIDCreate) SetName(s string) *WithUUIDIDCreate {
+	wuc.mutation.SetName(s)
+	return wuc
+}
+
+// SetID sets the "id" field.
+func (wuc *WithUUIDIDCreate) SetID(u uuid.UUID) *WithUUIDIDCreate {
+	wuc.mutation.SetID(u)
+	return wuc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wuc *WithUUIDIDCreate) SetNillableID(u *uuid.UUID) *WithUUIDIDCreate {
+	if u != nil {
+		wuc.SetID(*u)
+	}
+	return wuc
+}
+
+// Mutation returns the WithUUIDIDMutation object of the builder.
+func (wuc *WithUUIDIDCreate) Mutation() *WithUUIDIDMutation {
+	return wuc.mutation
+}
+
+// Save creates the WithUUIDID in the database.
+func (wuc *WithUUIDIDCreate) Save(ctx context.Context) (*WithUUIDID, error) {
+	var (
+		err  error
+		node *WithUUIDID
+	)
+	wuc.defaults()
+	if len(wuc.hooks) == 0 {
+		if err = wuc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wuc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithUUIDIDMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wuc.check(); err != nil {
+				return nil, err
+			}
+			wuc.mutation = mutation
+			if node, err = wuc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wuc.hooks) - 1; i >= 0; i-- {
+			if wuc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wuc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wuc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WithUUIDID)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WithUUIDIDMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wuc *WithUUIDIDCreate) SaveX(ctx context.Context) *WithUUIDID {
+	v, err := wuc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wuc *WithUUIDIDCreate) Exec(ctx context.Context) error {
+	_, err := wuc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuc *WithUUIDIDCreate) ExecX(ctx context.Context) {
+	if err := wuc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuc *WithUUIDIDCreate) defaults() {
+	if _, ok := wuc.mutation.ID(); !ok {
+		v := withuuidid.DefaultID()
+		wuc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuc *WithUUIDIDCreate) check() error {
+	if _, ok := wuc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WithUUIDID.name"`)}
+	}
+	return nil
+}
+
+func (wuc *WithUUIDIDCreate) sqlSave(ctx context.Context) (*WithUUIDID, error) {
+	_node, _spec := wuc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wuc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (wuc *WithUUIDIDCreate) createSpec() (*WithUUIDID, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WithUUIDID{config: wuc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: withuuidid.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: withuuidid.FieldID,
+			},
+		}
+	)
+	if id, ok := wuc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wuc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withuuidid.FieldName,
+		})
+		_node.Name = value
+	}
+	return _node, _spec
+}
+
+// WithUUIDIDCreateBulk is the builder for creating many WithUUIDID entities in bulk.
+type WithUUIDIDCreateBulk struct {
+	config
+	builders []*WithUUIDIDCreate
+}
+
+// Save creates the WithUUIDID entities in the database.
+func (wucb *WithUUIDIDCreateBulk) Save(ctx context.Context) ([]*WithUUIDID, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wucb.builders))
+	nodes := make([]*WithUUIDID, len(wucb.builders))
+	mutators := make([]Mutator, len(wucb.builders))
+	for i := range wucb.builders {
+		func(i int, root context.Context) {
+			builder := wucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WithUUIDIDMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wucb *WithUUIDIDCreateBulk) SaveX(ctx context.Context) []*WithUUIDID {
+	v, err := wucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wucb *WithUUIDIDCreateBulk) Exec(ctx context.Context) error {
+	_, err := wucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wucb *WithUUIDIDCreateBulk) ExecX(ctx context.Context) {
+	if err := wucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
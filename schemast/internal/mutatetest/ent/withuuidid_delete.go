@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withuuidid"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithUUIDIDDelete is the builder for deleting a WithUUIDID entity.
+type WithUUIDIDDelete struct {
+	config
+	hooks    []Hook
+	mutation *WithUUIDIDMutation
+}
+
+// Where appends a list predicates to the WithUUIDIDDelete builder.
+func (wud *WithUUIDIDDelete) Where(ps ...predicate.WithUUIDID) *WithUUIDIDDelete {
+	wud.mutation.Where(ps...)
+	return wud
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wud *WithUUIDIDDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wud.hooks) == 0 {
+		affected, err = wud.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithUUIDIDMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wud.mutation = mutation
+			affected, err = wud.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wud.hooks) - 1; i >= 0; i-- {
+			if wud.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wud.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wud.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wud *WithUUIDIDDelete) ExecX(ctx context.Context) int {
+	n, err := wud.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wud *WithUUIDIDDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: withuuidid.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: withuuidid.FieldID,
+			},
+		},
+	}
+	if ps := wud.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wud.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// WithUUIDIDDeleteOne is the builder for deleting a single WithUUIDID entity.
+type WithUUIDIDDeleteOne struct {
+	wud *WithUUIDIDDelete
+}
+
+// Exec executes the deletion query.
+func (wudo *WithUUIDIDDeleteOne) Exec(ctx context.Context) error {
+	n, err := wudo.wud.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{withuuidid.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wudo *WithUUIDIDDeleteOne) ExecX(ctx context.Context) {
+	wudo.wud.ExecX(ctx)
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withuuidid"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WithUUIDIDQuery is the builder for querying WithUUIDID entities.
+type WithUUIDIDQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WithUUIDID
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WithUUIDIDQuery builder.
+func (wuq *WithUUIDIDQuery) Where(ps ...predicate.WithUUIDID) *WithUUIDIDQuery {
+	wuq.predicates = append(wuq.predicates, ps...)
+	return wuq
+}
+
+// Limit adds a limit step to the query.
+func (wuq *WithUUIDIDQuery) Limit(limit int) *WithUUIDIDQuery {
+	wuq.limit = &limit
+	return wuq
+}
+
+// Offset adds an offset step to the query.
+func (wuq *WithUUIDIDQuery) Offset(offset int) *WithUUIDIDQuery {
+	wuq.offset = &offset
+	return wuq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wuq *WithUUIDIDQuery) Unique(unique bool) *WithUUIDIDQuery {
+	wuq.unique = &unique
+	return wuq
+}
+
+// Order adds an order step to the query.
+func (wuq *WithUUIDIDQuery) Order(o ...OrderFunc) *WithUUIDIDQuery {
+	wuq.order = append(wuq.order, o...)
+	return wuq
+}
+
+// First returns the first WithUUIDID entity from the query.
+// Returns a *NotFoundError when no WithUUIDID was found.
+func (wuq *WithUUIDIDQuery) First(ctx context.Context) (*WithUUIDID, error) {
+	nodes, err := wuq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{withuuidid.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wuq *WithUUIDIDQuery) FirstX(ctx context.Context) *WithUUIDID {
+	node, err := wuq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WithUUIDID ID from the query.
+// Returns a *NotFoundError when no WithUUIDID ID was found.
+func (wuq *WithUUIDIDQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wuq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{withuuidid.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wuq *WithUUIDIDQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wuq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WithUUIDID entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WithUUIDID entity is found.
+// Returns a *NotFoundError when no WithUUIDID entities are found.
+func (wuq *WithUUIDIDQuery) Only(ctx context.Context) (*WithUUIDID, error) {
+	nodes, err := wuq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{withuuidid.Label}
+	default:
+		return nil, &NotSingularError{withuuidid.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wuq *WithUUIDIDQuery) OnlyX(ctx context.Context) *WithUUIDID {
+	node, err := wuq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WithUUIDID ID in the query.
+// Returns a *NotSingularError when more than one WithUUIDID ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wuq *WithUUIDIDQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wuq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{withuuidid.Label}
+	default:
+		err = &NotSingularError{withuuidid.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wuq *WithUUIDIDQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wuq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WithUUIDIDs.
+func (wuq *WithUUIDIDQuery) All(ctx context.Context) ([]*WithUUIDID, error) {
+	if err := wuq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wuq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wuq *WithUUIDIDQuery) AllX(ctx context.Context) []*WithUUIDID {
+	nodes, err := wuq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WithUUIDID IDs.
+func (wuq *WithUUIDIDQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := wuq.Select(withuuidid.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wuq *WithUUIDIDQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wuq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wuq *WithUUIDIDQuery) Count(ctx context.Context) (int, error) {
+	if err := wuq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wuq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wuq *WithUUIDIDQuery) CountX(ctx context.Context) int {
+	count, err := wuq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wuq *WithUUIDIDQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wuq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wuq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wuq *WithUUIDIDQuery) ExistX(ctx context.Context) bool {
+	exist, err := wuq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WithUUIDIDQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wuq *WithUUIDIDQuery) Clone() *WithUUIDIDQuery {
+	if wuq == nil {
+		return nil
+	}
+	return &WithUUIDIDQuery{
+		config:     wuq.config,
+		limit:      wuq.limit,
+		offset:     wuq.offset,
+		order:      append([]OrderFunc{}, wuq.order...),
+		predicates: append([]predicate.WithUUIDID{}, wuq.predicates...),
+		// clone intermediate query.
+		sql:    wuq.sql.Clone(),
+		path:   wuq.path,
+		unique: wuq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WithUUIDID.Query().
+//		GroupBy(withuuidid.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wuq *WithUUIDIDQuery) GroupBy(field string, fields ...string) *WithUUIDIDGroupBy {
+	grbuild := &WithUUIDIDGroupBy{config: wuq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wuq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wuq.sqlQuery(ctx), nil
+	}
+	grbuild.label = withuuidid.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.WithUUIDID.Query().
+//		Select(withuuidid.FieldName).
+//		Scan(ctx, &v)
+func (wuq *WithUUIDIDQuery) Select(fields ...string) *WithUUIDIDSelect {
+	wuq.fields = append(wuq.fields, fields...)
+	selbuild := &WithUUIDIDSelect{WithUUIDIDQuery: wuq}
+	selbuild.label = withuuidid.Label
+	selbuild.flds, selbuild.scan = &wuq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (wuq *WithUUIDIDQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wuq.fields {
+		if !withuuidid.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wuq.path != nil {
+		prev, err := wuq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wuq.sql = prev
+	}
+	return nil
+}
+
+func (wuq *WithUUIDIDQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WithUUIDID, error) {
+	var (
+		nodes = []*WithUUIDID{}
+		_spec = wuq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WithUUIDID).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WithUUIDID{config: wuq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wuq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wuq *WithUUIDIDQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wuq.querySpec()
+	_spec.Node.Columns = wuq.fields
+	if len(wuq.fields) > 0 {
+		_spec.Unique = wuq.unique != nil && *wuq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wuq.driver, _spec)
+}
+
+func (wuq *WithUUIDIDQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wuq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wuq *WithUUIDIDQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withuuidid.Table,
+			Columns: withuuidid.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: withuuidid.FieldID,
+			},
+		},
+		From:   wuq.sql,
+		Unique: true,
+	}
+	if unique := wuq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wuq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withuuidid.FieldID)
+		for i := range fields {
+			if fields[i] != withuuidid.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wuq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wuq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wuq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wuq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wuq *WithUUIDIDQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wuq.driver.Dialect())
+	t1 := builder.Table(withuuidid.Table)
+	columns := wuq.fields
+	if len(columns) == 0 {
+		columns = withuuidid.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wuq.sql != nil {
+		selector = wuq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wuq.unique != nil && *wuq.unique {
+		selector.Distinct()
+	}
+	for _, p := range wuq.predicates {
+		p(selector)
+	}
+	for _, p := range wuq.order {
+		p(selector)
+	}
+	if offset := wuq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wuq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithUUIDIDGroupBy is the group-by builder for WithUUIDID entities.
+type WithUUIDIDGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wugb *WithUUIDIDGroupBy) Aggregate(fns ...AggregateFunc) *WithUUIDIDGroupBy {
+	wugb.fns = append(wugb.fns, fns...)
+	return wugb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wugb *WithUUIDIDGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := wugb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wugb.sql = query
+	return wugb.sqlScan(ctx, v)
+}
+
+func (wugb *WithUUIDIDGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range wugb.fields {
+		if !withuuidid.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wugb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wugb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wugb *WithUUIDIDGroupBy) sqlQuery() *sql.Selector {
+	selector := wugb.sql.Select()
+	aggregation := make([]string, 0, len(wugb.fns))
+	for _, fn := range wugb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wugb.fields)+len(wugb.fns))
+		for _, f := range wugb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wugb.fields...)...)
+}
+
+// WithUUIDIDSelect is the builder for selecting fields of WithUUIDID entities.
+type WithUUIDIDSelect struct {
+	*WithUUIDIDQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wus *WithUUIDIDSelect) Scan(ctx context.Context, v any) error {
+	if err := wus.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wus.sql = wus.WithUUIDIDQuery.sqlQuery(ctx)
+	return wus.sqlScan(ctx, v)
+}
+
+func (wus *WithUUIDIDSelect) sqlScan(ctx context.Context, v any) error {
+	rows := &sql.Rows{}
+	query, args := wus.sql.Query()
+	if err := wus.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withuuidid"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithUUIDIDUpdate is the builder for updating WithUUIDID entities.
+type WithUUIDIDUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WithUUIDIDMutation
+}
+
+// Where appends a list predicates to the WithUUIDIDUpdate builder.
+func (wuu *WithUUIDIDUpdate) Where(ps ...predicate.WithUUIDID) *WithUUIDIDUpdate {
+	wuu.mutation.Where(ps...)
+	return wuu
+}
+
+// SetName sets the "name" field.
+func (wuu *WithUUIDIDUpdate) SetName(s string) *WithUUIDIDUpdate {
+	wuu.mutation.SetName(s)
+	return wuu
+}
+
+// Mutation returns the WithUUIDIDMutation object of the builder.
+func (wuu *WithUUIDIDUpdate) Mutation() *WithUUIDIDMutation {
+	return wuu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wuu *WithUUIDIDUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wuu.hooks) == 0 {
+		affected, err = wuu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithUUIDIDMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wuu.mutation = mutation
+			affected, err = wuu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wuu.hooks) - 1; i >= 0; i-- {
+			if wuu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wuu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wuu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuu *WithUUIDIDUpdate) SaveX(ctx context.Context) int {
+	affected, err := wuu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wuu *WithUUIDIDUpdate) Exec(ctx context.Context) error {
+	_, err := wuu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuu *WithUUIDIDUpdate) ExecX(ctx context.Context) {
+	if err := wuu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wuu *WithUUIDIDUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withuuidid.Table,
+			Columns: withuuidid.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: withuuidid.FieldID,
+			},
+		},
+	}
+	if ps := wuu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withuuidid.FieldName,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wuu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withuuidid.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WithUUIDIDUpdateOne is the builder for updating a single WithUUIDID entity.
+type WithUUIDIDUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WithUUIDIDMutation
+}
+
+// SetName sets the "name" field.
+func (wuuo *WithUUIDIDUpdateOne) SetName(s string) *WithUUIDIDUpdateOne {
+	wuuo.mutation.SetName(s)
+	return wuuo
+}
+
+// Mutation returns the WithUUIDIDMutation object of the builder.
+func (wuuo *WithUUIDIDUpdateOne) Mutation() *WithUUIDIDMutation {
+	return wuuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuuo *WithUUIDIDUpdateOne) Select(field string, fields ...string) *WithUUIDIDUpdateOne {
+	wuuo.fields = append([]string{field}, fields...)
+	return wuuo
+}
+
+// Save executes the query and returns the updated WithUUIDID entity.
+func (wuuo *WithUUIDIDUpdateOne) Save(ctx context.Context) (*WithUUIDID, error) {
+	var (
+		err  error
+		node *WithUUIDID
+	)
+	if len(wuuo.hooks) == 0 {
+		node, err = wuuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithUUIDIDMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wuuo.mutation = mutation
+			node, err = wuuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wuuo.hooks) - 1; i >= 0; i-- {
+			if wuuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wuuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wuuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WithUUIDID)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WithUUIDIDMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuuo *WithUUIDIDUpdateOne) SaveX(ctx context.Context) *WithUUIDID {
+	node, err := wuuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuuo *WithUUIDIDUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuuo *WithUUIDIDUpdateOne) ExecX(ctx context.Context) {
+	if err := wuuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wuuo *WithUUIDIDUpdateOne) sqlSave(ctx context.Context) (_node *WithUUIDID, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withuuidid.Table,
+			Columns: withuuidid.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: withuuidid.FieldID,
+			},
+		},
+	}
+	id, ok := wuuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WithUUIDID.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withuuidid.FieldID)
+		for _, f := range fields {
+			if !withuuidid.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != withuuidid.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withuuidid.FieldName,
+		})
+	}
+	_node = &WithUUIDID{config: wuuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withuuidid.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withvalidator"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WithValidator is the model entity for the WithValidator schema.
+type WithValidator struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WithValidator) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case withvalidator.FieldID:
+			values[i] = new(sql.NullInt64)
+		case withvalidator.FieldName, withvalidator.FieldSlug:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WithValidator", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WithValidator fields.
+func (wv *WithValidator) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case withvalidator.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wv.ID = int(value.Int64)
+		case withvalidator.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				wv.Name = value.String
+			}
+		case withvalidator.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				wv.Slug = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this WithValidator.
+// Note that you need to call WithValidator.Unwrap() before calling this method if this WithValidator
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wv *WithValidator) Update() *WithValidatorUpdateOne {
+	return (&WithValidatorClient{config: wv.config}).UpdateOne(wv)
+}
+
+// Unwrap unwraps the WithValidator entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wv *WithValidator) Unwrap() *WithValidator {
+	_tx, ok := wv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WithValidator is not a transactional entity")
+	}
+	wv.config.driver = _tx.drv
+	return wv
+}
+
+// String implements the fmt.Stringer.
+func (wv *WithValidator) String() string {
+	var builder strings.Builder
+	builder.WriteString("WithValidator(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wv.ID))
+	builder.WriteString("name=")
+	builder.WriteString(wv.Name)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(wv.Slug)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WithValidators is a parsable slice of WithValidator.
+type WithValidators []*WithValidator
+
+func (wv WithValidators) config(cfg config) {
+	for _i := range wv {
+		wv[_i].config = cfg
+	}
+}
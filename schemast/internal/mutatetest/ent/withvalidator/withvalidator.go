@@ -0,0 +1,38 @@
+// Code generated by ent, DO NOT EDIT.
+
+package withvalidator
+
+const (
+	// Label holds the string label denoting the withvalidator type in the database.
+	Label = "with_validator"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
+	// Table holds the table name of the withvalidator in the database.
+	Table = "with_validators"
+)
+
+// Columns holds all SQL columns for withvalidator fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldSlug,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	SlugValidator func(string) error
+)
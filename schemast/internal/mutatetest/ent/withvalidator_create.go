@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withvalidator"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithValidatorCreate is the builder for creating a WithValidator entity.
+type WithValidatorCreate struct {
+	config
+	mutation *WithValidatorMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (wvc *WithValidatorCreate) SetName(s string) *WithValidatorCreate {
+	wvc.mutation.SetName(s)
+	return wvc
+}
+
+// SetSlug sets the "slug" field.
+func (wvc *WithValidatorCreate) SetSlug(s string) *WithValidatorCreate {
+	wvc.mutation.SetSlug(s)
+	return wvc
+}
+
+// Mutation returns the WithValidatorMutation object of the builder.
+func (wvc *WithValidatorCreate) Mutation() *WithValidatorMutation {
+	return wvc.mutation
+}
+
+// Save creates the WithValidator in the database.
+func (wvc *WithValidatorCreate) Save(ctx context.Context) (*WithValidator, error) {
+	var (
+		err  error
+		node *WithValidator
+	)
+	if len(wvc.hooks) == 0 {
+		if err = wvc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wvc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithValidatorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wvc.check(); err != nil {
+				return nil, err
+			}
+			wvc.mutation = mutation
+			if node, err = wvc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wvc.hooks) - 1; i >= 0; i-- {
+			if wvc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wvc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wvc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WithValidator)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WithValidatorMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wvc *WithValidatorCreate) SaveX(ctx context.Context) *WithValidator {
+	v, err := wvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wvc *WithValidatorCreate) Exec(ctx context.Context) error {
+	_, err := wvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wvc *WithValidatorCreate) ExecX(ctx context.Context) {
+	if err := wvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wvc *WithValidatorCreate) check() error {
+	if _, ok := wvc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WithValidator.name"`)}
+	}
+	if _, ok := wvc.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "WithValidator.slug"`)}
+	}
+	if v, ok := wvc.mutation.Slug(); ok {
+		if err := withvalidator.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "WithValidator.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wvc *WithValidatorCreate) sqlSave(ctx context.Context) (*WithValidator, error) {
+	_node, _spec := wvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wvc *WithValidatorCreate) createSpec() (*WithValidator, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WithValidator{config: wvc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: withvalidator.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withvalidator.FieldID,
+			},
+		}
+	)
+	if value, ok := wvc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withvalidator.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := wvc.mutation.Slug(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withvalidator.FieldSlug,
+		})
+		_node.Slug = value
+	}
+	return _node, _spec
+}
+
+// WithValidatorCreateBulk is the builder for creating many WithValidator entities in bulk.
+type WithValidatorCreateBulk struct {
+	config
+	builders []*WithValidatorCreate
+}
+
+// Save creates the WithValidator entities in the database.
+func (wvcb *WithValidatorCreateBulk) Save(ctx context.Context) ([]*WithValidator, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wvcb.builders))
+	nodes := make([]*WithValidator, len(wvcb.builders))
+	mutators := make([]Mutator, len(wvcb.builders))
+	for i := range wvcb.builders {
+		func(i int, root context.Context) {
+			builder := wvcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WithValidatorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wvcb *WithValidatorCreateBulk) SaveX(ctx context.Context) []*WithValidator {
+	v, err := wvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wvcb *WithValidatorCreateBulk) Exec(ctx context.Context) error {
+	_, err := wvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wvcb *WithValidatorCreateBulk) ExecX(ctx context.Context) {
+	if err := wvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
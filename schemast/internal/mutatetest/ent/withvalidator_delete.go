@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withvalidator"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithValidatorDelete is the builder for deleting a WithValidator entity.
+type WithValidatorDelete struct {
+	config
+	hooks    []Hook
+	mutation *WithValidatorMutation
+}
+
+// Where appends a list predicates to the WithValidatorDelete builder.
+func (wvd *WithValidatorDelete) Where(ps ...predicate.WithValidator) *WithValidatorDelete {
+	wvd.mutation.Where(ps...)
+	return wvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wvd *WithValidatorDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wvd.hooks) == 0 {
+		affected, err = wvd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithValidatorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wvd.mutation = mutation
+			affected, err = wvd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wvd.hooks) - 1; i >= 0; i-- {
+			if wvd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wvd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wvd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wvd *WithValidatorDelete) ExecX(ctx context.Context) int {
+	n, err := wvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wvd *WithValidatorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: withvalidator.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withvalidator.FieldID,
+			},
+		},
+	}
+	if ps := wvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// WithValidatorDeleteOne is the builder for deleting a single WithValidator entity.
+type WithValidatorDeleteOne struct {
+	wvd *WithValidatorDelete
+}
+
+// Exec executes the deletion query.
+func (wvdo *WithValidatorDeleteOne) Exec(ctx context.Context) error {
+	n, err := wvdo.wvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{withvalidator.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wvdo *WithValidatorDeleteOne) ExecX(ctx context.Context) {
+	wvdo.wvd.ExecX(ctx)
+}
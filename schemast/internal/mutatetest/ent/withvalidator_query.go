@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withvalidator"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithValidatorQuery is the builder for querying WithValidator entities.
+type WithValidatorQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WithValidator
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WithValidatorQuery builder.
+func (wvq *WithValidatorQuery) Where(ps ...predicate.WithValidator) *WithValidatorQuery {
+	wvq.predicates = append(wvq.predicates, ps...)
+	return wvq
+}
+
+// Limit adds a limit step to the query.
+func (wvq *WithValidatorQuery) Limit(limit int) *WithValidatorQuery {
+	wvq.limit = &limit
+	return wvq
+}
+
+// Offset adds an offset step to the query.
+func (wvq *WithValidatorQuery) Offset(offset int) *WithValidatorQuery {
+	wvq.offset = &offset
+	return wvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wvq *WithValidatorQuery) Unique(unique bool) *WithValidatorQuery {
+	wvq.unique = &unique
+	return wvq
+}
+
+// Order adds an order step to the query.
+func (wvq *WithValidatorQuery) Order(o ...OrderFunc) *WithValidatorQuery {
+	wvq.order = append(wvq.order, o...)
+	return wvq
+}
+
+// First returns the first WithValidator entity from the query.
+// Returns a *NotFoundError when no WithValidator was found.
+func (wvq *WithValidatorQuery) First(ctx context.Context) (*WithValidator, error) {
+	nodes, err := wvq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{withvalidator.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wvq *WithValidatorQuery) FirstX(ctx context.Context) *WithValidator {
+	node, err := wvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WithValidator ID from the query.
+// Returns a *NotFoundError when no WithValidator ID was found.
+func (wvq *WithValidatorQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wvq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{withvalidator.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wvq *WithValidatorQuery) FirstIDX(ctx context.Context) int {
+	id, err := wvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WithValidator entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WithValidator entity is found.
+// Returns a *NotFoundError when no WithValidator entities are found.
+func (wvq *WithValidatorQuery) Only(ctx context.Context) (*WithValidator, error) {
+	nodes, err := wvq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{withvalidator.Label}
+	default:
+		return nil, &NotSingularError{withvalidator.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wvq *WithValidatorQuery) OnlyX(ctx context.Context) *WithValidator {
+	node, err := wvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WithValidator ID in the query.
+// Returns a *NotSingularError when more than one WithValidator ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wvq *WithValidatorQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wvq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{withvalidator.Label}
+	default:
+		err = &NotSingularError{withvalidator.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wvq *WithValidatorQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WithValidators.
+func (wvq *WithValidatorQuery) All(ctx context.Context) ([]*WithValidator, error) {
+	if err := wvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wvq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wvq *WithValidatorQuery) AllX(ctx context.Context) []*WithValidator {
+	nodes, err := wvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WithValidator IDs.
+func (wvq *WithValidatorQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wvq.Select(withvalidator.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wvq *WithValidatorQuery) IDsX(ctx context.Context) []int {
+	ids, err := wvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wvq *WithValidatorQuery) Count(ctx context.Context) (int, error) {
+	if err := wvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wvq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wvq *WithValidatorQuery) CountX(ctx context.Context) int {
+	count, err := wvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wvq *WithValidatorQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wvq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wvq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wvq *WithValidatorQuery) ExistX(ctx context.Context) bool {
+	exist, err := wvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WithValidatorQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wvq *WithValidatorQuery) Clone() *WithValidatorQuery {
+	if wvq == nil {
+		return nil
+	}
+	return &WithValidatorQuery{
+		config:     wvq.config,
+		limit:      wvq.limit,
+		offset:     wvq.offset,
+		order:      append([]OrderFunc{}, wvq.order...),
+		predicates: append([]predicate.WithValidator{}, wvq.predicates...),
+		// clone intermediate query.
+		sql:    wvq.sql.Clone(),
+		path:   wvq.path,
+		unique: wvq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WithValidator.Query().
+//		GroupBy(withvalidator.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wvq *WithValidatorQuery) GroupBy(field string, fields ...string) *WithValidatorGroupBy {
+	grbuild := &WithValidatorGroupBy{config: wvq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wvq.sqlQuery(ctx), nil
+	}
+	grbuild.label = withvalidator.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.WithValidator.Query().
+//		Select(withvalidator.FieldName).
+//		Scan(ctx, &v)
+func (wvq *WithValidatorQuery) Select(fields ...string) *WithValidatorSelect {
+	wvq.fields = append(wvq.fields, fields...)
+	selbuild := &WithValidatorSelect{WithValidatorQuery: wvq}
+	selbuild.label = withvalidator.Label
+	selbuild.flds, selbuild.scan = &wvq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (wvq *WithValidatorQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wvq.fields {
+		if !withvalidator.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wvq.path != nil {
+		prev, err := wvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wvq.sql = prev
+	}
+	return nil
+}
+
+func (wvq *WithValidatorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WithValidator, error) {
+	var (
+		nodes = []*WithValidator{}
+		_spec = wvq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WithValidator).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WithValidator{config: wvq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wvq *WithValidatorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wvq.querySpec()
+	_spec.Node.Columns = wvq.fields
+	if len(wvq.fields) > 0 {
+		_spec.Unique = wvq.unique != nil && *wvq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wvq.driver, _spec)
+}
+
+func (wvq *WithValidatorQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wvq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wvq *WithValidatorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withvalidator.Table,
+			Columns: withvalidator.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withvalidator.FieldID,
+			},
+		},
+		From:   wvq.sql,
+		Unique: true,
+	}
+	if unique := wvq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wvq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withvalidator.FieldID)
+		for i := range fields {
+			if fields[i] != withvalidator.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wvq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wvq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wvq *WithValidatorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wvq.driver.Dialect())
+	t1 := builder.Table(withvalidator.Table)
+	columns := wvq.fields
+	if len(columns) == 0 {
+		columns = withvalidator.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wvq.sql != nil {
+		selector = wvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wvq.unique != nil && *wvq.unique {
+		selector.Distinct()
+	}
+	for _, p := range wvq.predicates {
+		p(selector)
+	}
+	for _, p := range wvq.order {
+		p(selector)
+	}
+	if offset := wvq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wvq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithValidatorGroupBy is the group-by builder for WithValidator entities.
+type WithValidatorGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wvgb *WithValidatorGroupBy) Aggregate(fns ...AggregateFunc) *WithValidatorGroupBy {
+	wvgb.fns = append(wvgb.fns, fns...)
+	return wvgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wvgb *WithValidatorGroupBy) Scan(ctx context.Context, v any) error {
+	query, err := wvgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wvgb.sql = query
+	return wvgb.sqlScan(ctx, v)
+}
+
+func (wvgb *WithValidatorGroupBy) sqlScan(ctx context.Context, v any) error {
+	for _, f := range wvgb.fields {
+		if !withvalidator.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wvgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wvgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wvgb *WithValidatorGroupBy) sqlQuery() *sql.Selector {
+	selector := wvgb.sql.Select()
+	aggregation := make([]string, 0, len(wvgb.fns))
+	for _, fn := range wvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wvgb.fields)+len(wvgb.fns))
+		for _, f := range wvgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wvgb.fields...)...)
+}
+
+// WithValidatorSelect is the builder for selecting fields of WithValidator entities.
+type WithValidatorSelect struct {
+	*WithValidatorQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wvs *WithValidatorSelect) Scan(ctx context.Context, v any) error {
+	if err := wvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wvs.sql = wvs.WithValidatorQuery.sqlQuery(ctx)
+	return wvs.sqlScan(ctx, v)
+}
+
+func (wvs *WithValidatorSelect) sqlScan(ctx context.Context, v any) error {
+	rows := &sql.Rows{}
+	query, args := wvs.sql.Query()
+	if err := wvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/predicate"
+	"entgo.io/contrib/schemast/internal/mutatetest/ent/withvalidator"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WithValidatorUpdate is the builder for updating WithValidator entities.
+type WithValidatorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WithValidatorMutation
+}
+
+// Where appends a list predicates to the WithValidatorUpdate builder.
+func (wvu *WithValidatorUpdate) Where(ps ...predicate.WithValidator) *WithValidatorUpdate {
+	wvu.mutation.Where(ps...)
+	return wvu
+}
+
+// SetName sets the "name" field.
+func (wvu *WithValidatorUpdate) SetName(s string) *WithValidatorUpdate {
+	wvu.mutation.SetName(s)
+	return wvu
+}
+
+// SetSlug sets the "slug" field.
+func (wvu *WithValidatorUpdate) SetSlug(s string) *WithValidatorUpdate {
+	wvu.mutation.SetSlug(s)
+	return wvu
+}
+
+// Mutation returns the WithValidatorMutation object of the builder.
+func (wvu *WithValidatorUpdate) Mutation() *WithValidatorMutation {
+	return wvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wvu *WithValidatorUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wvu.hooks) == 0 {
+		if err = wvu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = wvu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithValidatorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wvu.check(); err != nil {
+				return 0, err
+			}
+			wvu.mutation = mutation
+			affected, err = wvu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wvu.hooks) - 1; i >= 0; i-- {
+			if wvu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wvu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wvu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wvu *WithValidatorUpdate) SaveX(ctx context.Context) int {
+	affected, err := wvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wvu *WithValidatorUpdate) Exec(ctx context.Context) error {
+	_, err := wvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wvu *WithValidatorUpdate) ExecX(ctx context.Context) {
+	if err := wvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wvu *WithValidatorUpdate) check() error {
+	if v, ok := wvu.mutation.Slug(); ok {
+		if err := withvalidator.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "WithValidator.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wvu *WithValidatorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withvalidator.Table,
+			Columns: withvalidator.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withvalidator.FieldID,
+			},
+		},
+	}
+	if ps := wvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wvu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withvalidator.FieldName,
+		})
+	}
+	if value, ok := wvu.mutation.Slug(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withvalidator.FieldSlug,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withvalidator.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WithValidatorUpdateOne is the builder for updating a single WithValidator entity.
+type WithValidatorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WithValidatorMutation
+}
+
+// SetName sets the "name" field.
+func (wvuo *WithValidatorUpdateOne) SetName(s string) *WithValidatorUpdateOne {
+	wvuo.mutation.SetName(s)
+	return wvuo
+}
+
+// SetSlug sets the "slug" field.
+func (wvuo *WithValidatorUpdateOne) SetSlug(s string) *WithValidatorUpdateOne {
+	wvuo.mutation.SetSlug(s)
+	return wvuo
+}
+
+// Mutation returns the WithValidatorMutation object of the builder.
+func (wvuo *WithValidatorUpdateOne) Mutation() *WithValidatorMutation {
+	return wvuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wvuo *WithValidatorUpdateOne) Select(field string, fields ...string) *WithValidatorUpdateOne {
+	wvuo.fields = append([]string{field}, fields...)
+	return wvuo
+}
+
+// Save executes the query and returns the updated WithValidator entity.
+func (wvuo *WithValidatorUpdateOne) Save(ctx context.Context) (*WithValidator, error) {
+	var (
+		err  error
+		node *WithValidator
+	)
+	if len(wvuo.hooks) == 0 {
+		if err = wvuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = wvuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WithValidatorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wvuo.check(); err != nil {
+				return nil, err
+			}
+			wvuo.mutation = mutation
+			node, err = wvuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wvuo.hooks) - 1; i >= 0; i-- {
+			if wvuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wvuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wvuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WithValidator)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WithValidatorMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wvuo *WithValidatorUpdateOne) SaveX(ctx context.Context) *WithValidator {
+	node, err := wvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wvuo *WithValidatorUpdateOne) Exec(ctx context.Context) error {
+	_, err := wvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wvuo *WithValidatorUpdateOne) ExecX(ctx context.Context) {
+	if err := wvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wvuo *WithValidatorUpdateOne) check() error {
+	if v, ok := wvuo.mutation.Slug(); ok {
+		if err := withvalidator.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "WithValidator.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wvuo *WithValidatorUpdateOne) sqlSave(ctx context.Context) (_node *WithValidator, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   withvalidator.Table,
+			Columns: withvalidator.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: withvalidator.FieldID,
+			},
+		},
+	}
+	id, ok := wvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WithValidator.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withvalidator.FieldID)
+		for _, f := range fields {
+			if !withvalidator.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != withvalidator.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wvuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withvalidator.FieldName,
+		})
+	}
+	if value, ok := wvuo.mutation.Slug(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: withvalidator.FieldSlug,
+		})
+	}
+	_node = &WithValidator{config: wvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withvalidator.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
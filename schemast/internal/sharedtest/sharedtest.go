@@ -0,0 +1,39 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sharedtest holds schema types shared with the schemas of other packages.
+package sharedtest
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SoftDelete holds the schema definition of a mixin that adds a deleted_at field.
+type SoftDelete struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDelete.
+func (SoftDelete) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").Optional(),
+	}
+}
+
+// Like holds the schema definition for the Like entity.
+type Like struct {
+	ent.Schema
+}
@@ -45,4 +45,12 @@ var (
 		methodName:    "Indexes",
 		ifaceSelector: selectorLit("ent", "Index"),
 	}
+	kindHook = kind{
+		methodName:    "Hooks",
+		ifaceSelector: selectorLit("ent", "Hook"),
+	}
+	kindMixin = kind{
+		methodName:    "Mixin",
+		ifaceSelector: selectorLit("ent", "Mixin"),
+	}
 )
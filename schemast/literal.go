@@ -0,0 +1,433 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// AppendOption modifies the behavior of AppendField.
+type AppendOption func(*appendOpts)
+
+type appendOpts struct {
+	group string
+	first bool
+	// at is the index of the field in the Fields method, if insert is true.
+	at     int
+	insert bool
+}
+
+// InGroup modifies AppendField to place the field under the banner comment "// --- name ---" in
+// the Fields method, after the last field of that group. If the banner does not exist, it is added
+// after all other fields.
+func InGroup(name string) AppendOption {
+	return func(opts *appendOpts) {
+		opts.group = name
+	}
+}
+
+// atStart modifies AppendField to place the field before all other fields, and before any comments
+// in the Fields method.
+func atStart() AppendOption {
+	return func(opts *appendOpts) {
+		opts.first = true
+	}
+}
+
+// atIndex modifies AppendField to place the field at index among the other fields. The field is placed right
+// after the field that precedes it, before the comments of the field that follows it.
+func atIndex(index int) AppendOption {
+	return func(opts *appendOpts) {
+		opts.at, opts.insert = index, true
+	}
+}
+
+var bannerRegexp = regexp.MustCompile(`^// --- .+ ---$`)
+
+func bannerComment(group string) string {
+	return "// --- " + group + " ---"
+}
+
+// returnedLiteral holds the source representation of the value returned by a schema method, such as
+// Fields or Edges. Free-floating comments (e.g. comments between fields) cannot be reliably placed
+// among AST nodes that were created by mutations, therefore methods that manage such comments edit
+// the source of the literal and parse the file again.
+type returnedLiteral struct {
+	kind     kind
+	typeName string
+	file     *ast.File
+	src      []byte
+	result   ast.Expr
+	entries  []literalEntry
+}
+
+// literalEntry is an element or a comment inside a returnedLiteral.
+type literalEntry struct {
+	pos  token.Pos
+	text string
+	// node is the element of the literal, or nil if the entry is a comment.
+	node ast.Expr
+	// comment is the comment that follows the element on its last line, e.g. "// x" for `field.Int("a"), // x`.
+	comment string
+}
+
+// returnedLiteral returns the returnedLiteral of the method of kind k of type typeName, adding the method
+// if it does not exist.
+func (c *Context) returnedLiteral(k kind, typeName string) (*returnedLiteral, error) {
+	if _, ok := c.lookupMethod(typeName, k.methodName); !ok {
+		if err := c.appendMethod(typeName, k.methodName, k.ifaceSelector); err != nil {
+			return nil, err
+		}
+	}
+	file, src, err := c.reparse(c.methodFile(typeName, k.methodName))
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := c.returnStmt(typeName, k.methodName)
+	if err != nil {
+		return nil, err
+	}
+	l := &returnedLiteral{kind: k, typeName: typeName, file: file, src: src, result: stmt.Results[0]}
+	switch r := stmt.Results[0].(type) {
+	case *ast.Ident:
+		if r.Name != "nil" {
+			return nil, fmt.Errorf("schemast: unexpected ident. expected nil got %s", r.Name)
+		}
+	case *ast.CompositeLit:
+		for _, elt := range r.Elts {
+			l.entries = append(l.entries, literalEntry{
+				pos:  elt.Pos(),
+				text: string(src[c.offset(elt.Pos()):c.offset(elt.End())]) + ",",
+				node: elt,
+			})
+		}
+		for _, cg := range file.Comments {
+			if cg.Pos() < r.Lbrace || cg.End() > r.Rbrace {
+				continue
+			}
+			for _, cm := range cg.List {
+				i := sort.Search(len(r.Elts), func(i int) bool { return r.Elts[i].Pos() > cm.Pos() }) - 1
+				switch {
+				case i >= 0 && cm.Pos() < r.Elts[i].End():
+					// The comments inside an element are part of its text.
+				case i >= 0 && l.entries[i].comment == "" && c.line(cm.Pos()) == c.line(r.Elts[i].End()):
+					l.entries[i].comment = cm.Text
+				default:
+					l.entries = append(l.entries, literalEntry{pos: cm.Pos(), text: cm.Text})
+				}
+			}
+		}
+		sort.Slice(l.entries, func(i, j int) bool {
+			return l.entries[i].pos < l.entries[j].pos
+		})
+	default:
+		return nil, fmt.Errorf("schemast: unexpected AST component type %T", r)
+	}
+	return l, nil
+}
+
+// rewrite replaces the literal in the source of its file with one holding entries, one per line,
+// and replaces the file in the Context with the parsed result.
+func (c *Context) rewrite(l *returnedLiteral, entries []literalEntry) error {
+	lines := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.comment != "" {
+			e.text += " " + e.comment
+		}
+		lines = append(lines, e.text)
+	}
+	sel := l.kind.ifaceSelector
+	lit := fmt.Sprintf("[]%s.%s{\n%s\n}", sel.X, sel.Sel, strings.Join(lines, "\n"))
+	src := string(l.src[:c.offset(l.result.Pos())]) + lit + string(l.src[c.offset(l.result.End()):])
+	_, _, err := c.replaceSource(l.file, []byte(src))
+	return err
+}
+
+// exprEntry returns a literalEntry holding the source of an expression created by a mutation.
+func (c *Context) exprEntry(expr ast.Expr) (literalEntry, error) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, c.SchemaPackage.Fset, expr); err != nil {
+		return literalEntry{}, err
+	}
+	return literalEntry{text: buf.String() + ",", node: expr}, nil
+}
+
+// commentEntries returns the entries of a line comment holding text.
+func commentEntries(text string) []literalEntry {
+	var entries []literalEntry
+	for _, line := range strings.Split(text, "\n") {
+		entries = append(entries, literalEntry{text: strings.TrimSpace("// " + line)})
+	}
+	return entries
+}
+
+// elementAt returns the element of the literal returned by the method of kind k of type typeName at index.
+func (c *Context) elementAt(k kind, typeName string, index int) (ast.Expr, error) {
+	stmt, err := c.returnStmt(typeName, k.methodName)
+	if err != nil {
+		return nil, err
+	}
+	lit, ok := stmt.Results[0].(*ast.CompositeLit)
+	if !ok || index >= len(lit.Elts) {
+		return nil, fmt.Errorf("schemast: could not find element %d of %s() of type %q", index, k.methodName, typeName)
+	}
+	return lit.Elts[index], nil
+}
+
+// appendToGroup adds item to the returned values of the method of kind k of type typeName, under
+// the banner comment of group. The returned expression is the added item in the parsed file.
+func (c *Context) appendToGroup(k kind, typeName, group string, item ast.Expr) (ast.Expr, error) {
+	l, err := c.returnedLiteral(k, typeName)
+	if err != nil {
+		return nil, err
+	}
+	added, err := c.exprEntry(item)
+	if err != nil {
+		return nil, err
+	}
+	inserted := []literalEntry{added}
+	at := -1
+	for i, e := range l.entries {
+		if e.text == bannerComment(group) {
+			at = len(l.entries)
+			for j := i + 1; j < len(l.entries); j++ {
+				if l.entries[j].node == nil && bannerRegexp.MatchString(l.entries[j].text) {
+					at = j
+					break
+				}
+			}
+			break
+		}
+	}
+	if at == -1 {
+		at = len(l.entries)
+		inserted = append([]literalEntry{{text: bannerComment(group)}}, inserted...)
+	}
+	var index int
+	for _, e := range l.entries[:at] {
+		if e.node != nil {
+			index++
+		}
+	}
+	entries := append(append(append([]literalEntry{}, l.entries[:at]...), inserted...), l.entries[at:]...)
+	if err := c.rewrite(l, entries); err != nil {
+		return nil, err
+	}
+	return c.elementAt(k, typeName, index)
+}
+
+// prependItem adds item before all other returned values, and comments, of the method of kind k of type
+// typeName. The returned expression is the added item in the parsed file.
+func (c *Context) prependItem(k kind, typeName string, item ast.Expr) (ast.Expr, error) {
+	l, err := c.returnedLiteral(k, typeName)
+	if err != nil {
+		return nil, err
+	}
+	added, err := c.exprEntry(item)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.rewrite(l, append([]literalEntry{added}, l.entries...)); err != nil {
+		return nil, err
+	}
+	return c.elementAt(k, typeName, 0)
+}
+
+// literalUnits splits the entries of a literal into units that are moved together: an element along with the
+// comments that directly precede it (e.g. its doc comment), or a banner or trailing comment on its own.
+func literalUnits(entries []literalEntry) [][]literalEntry {
+	var (
+		units   [][]literalEntry
+		pending []literalEntry
+	)
+	flush := func() {
+		for _, e := range pending {
+			units = append(units, []literalEntry{e})
+		}
+		pending = nil
+	}
+	for _, e := range entries {
+		switch {
+		case e.node != nil:
+			units = append(units, append(pending, e))
+			pending = nil
+		case bannerRegexp.MatchString(e.text):
+			flush()
+			units = append(units, []literalEntry{e})
+		default:
+			pending = append(pending, e)
+		}
+	}
+	flush()
+	return units
+}
+
+// insertUnit inserts unit in units, such that its element is at index among the elements of units.
+func insertUnit(units [][]literalEntry, unit []literalEntry, index int) ([][]literalEntry, error) {
+	at, n := 0, 0
+	for i, u := range units {
+		if n == index {
+			break
+		}
+		if u[len(u)-1].node != nil {
+			n++
+			at = i + 1
+		}
+	}
+	if index < 0 || n != index {
+		return nil, fmt.Errorf("schemast: index %d is out of range [0, %d]", index, n)
+	}
+	return append(append(append([][]literalEntry{}, units[:at]...), unit), units[at:]...), nil
+}
+
+// insertItem adds item at index among the returned values of the method of kind k of type typeName. The
+// returned expression is the added item in the parsed file.
+func (c *Context) insertItem(k kind, typeName string, index int, item ast.Expr) (ast.Expr, error) {
+	l, err := c.returnedLiteral(k, typeName)
+	if err != nil {
+		return nil, err
+	}
+	added, err := c.exprEntry(item)
+	if err != nil {
+		return nil, err
+	}
+	units, err := insertUnit(literalUnits(l.entries), []literalEntry{added}, index)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.rewrite(l, joinUnits(units)); err != nil {
+		return nil, err
+	}
+	return c.elementAt(k, typeName, index)
+}
+
+// moveItem moves the returned value at index from of the method of kind k of type typeName, along with the
+// comments that directly precede it, to index to.
+func (c *Context) moveItem(k kind, typeName string, from, to int) error {
+	l, err := c.returnedLiteral(k, typeName)
+	if err != nil {
+		return err
+	}
+	var (
+		moved []literalEntry
+		units [][]literalEntry
+		n     int
+	)
+	for _, u := range literalUnits(l.entries) {
+		if u[len(u)-1].node == nil {
+			units = append(units, u)
+			continue
+		}
+		if n == from {
+			moved = u
+		} else {
+			units = append(units, u)
+		}
+		n++
+	}
+	if moved == nil {
+		return fmt.Errorf("schemast: could not find element %d of %s() of type %q", from, k.methodName, typeName)
+	}
+	if units, err = insertUnit(units, moved, to); err != nil {
+		return err
+	}
+	return c.rewrite(l, joinUnits(units))
+}
+
+// joinUnits returns the entries of units.
+func joinUnits(units [][]literalEntry) []literalEntry {
+	var entries []literalEntry
+	for _, u := range units {
+		entries = append(entries, u...)
+	}
+	return entries
+}
+
+// sortItems sorts the returned values of the method of kind k of type typeName by the names returned by name,
+// along with the comments that directly precede them. Values are only sorted among the values of the same
+// group, and banner comments are left in place. The method is left as is if it is not declared, if it does
+// not return a literal, if name fails for one of its values or if the values are already sorted.
+func (c *Context) sortItems(k kind, typeName string, name func(*ast.CallExpr) (string, error), less func(a, b string) bool) error {
+	if _, ok := c.lookupMethod(typeName, k.methodName); !ok {
+		return nil
+	}
+	stmt, err := c.returnStmt(typeName, k.methodName)
+	if err != nil {
+		return nil
+	}
+	lit, ok := stmt.Results[0].(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		call, ok := elt.(*ast.CallExpr)
+		if !ok {
+			return nil
+		}
+		n, err := name(call)
+		if err != nil {
+			return nil
+		}
+		names = append(names, n)
+	}
+	l, err := c.returnedLiteral(k, typeName)
+	if err != nil {
+		return err
+	}
+	// The names are keyed by the elements of the reparsed literal.
+	byNode := make(map[ast.Expr]string)
+	for i, elt := range l.result.(*ast.CompositeLit).Elts {
+		byNode[elt] = names[i]
+	}
+	unitLess := func(units [][]literalEntry) func(i, j int) bool {
+		return func(i, j int) bool {
+			return less(byNode[units[i][len(units[i])-1].node], byNode[units[j][len(units[j])-1].node])
+		}
+	}
+	var (
+		entries []literalEntry
+		run     [][]literalEntry
+		changed bool
+	)
+	flush := func() {
+		if !sort.SliceIsSorted(run, unitLess(run)) {
+			sort.SliceStable(run, unitLess(run))
+			changed = true
+		}
+		entries = append(entries, joinUnits(run)...)
+		run = nil
+	}
+	for _, u := range literalUnits(l.entries) {
+		if u[len(u)-1].node == nil {
+			flush()
+			entries = append(entries, u...)
+			continue
+		}
+		run = append(run, u)
+	}
+	flush()
+	if !changed {
+		return nil
+	}
+	return c.rewrite(l, entries)
+}
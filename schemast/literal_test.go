@@ -0,0 +1,141 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"bytes"
+	"go/printer"
+	"go/token"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendFieldInGroup(t *testing.T) {
+	tests := []struct {
+		name     string
+		group    string
+		expected string
+	}{
+		{
+			name:  "existing group",
+			group: "identity",
+			expected: `func (WithGroups) Fields() []ent.Field {
+	return []ent.Field{
+		// --- identity ---
+		field.String("name"),
+		field.String("nickname"),
+		// --- audit ---
+		field.Time("created_at"),
+	}
+}`,
+		},
+		{
+			name:  "new group",
+			group: "profile",
+			expected: `func (WithGroups) Fields() []ent.Field {
+	return []ent.Field{
+		// --- identity ---
+		field.String("name"),
+		// --- audit ---
+		field.Time("created_at"),
+		// --- profile ---
+		field.String("nickname"),
+	}
+}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := Load("./internal/mutatetest/ent/schema")
+			require.NoError(t, err)
+			expr, err := ctx.AppendFieldExpr("WithGroups", field.String("nickname").Descriptor(), InGroup(tt.group))
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), expr))
+			require.EqualValues(t, `field.String("nickname")`, buf.String())
+
+			buf.Reset()
+			file := ctx.methodFile("WithGroups", "Fields")
+			require.NoError(t, printer.Fprint(&buf, ctx.SchemaPackage.Fset, file))
+			require.Contains(t, buf.String(), tt.expected)
+		})
+	}
+}
+
+func TestAppendFieldInGroupNilFields(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, ctx.AppendField("WithNilFields", field.String("name").Descriptor(), InGroup("identity")))
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, ctx.SchemaPackage.Fset, ctx.methodFile("WithNilFields", "Fields")))
+	require.Contains(t, buf.String(), `func (WithNilFields) Fields() []ent.Field {
+	return []ent.Field{
+		// --- identity ---
+		field.String("name"),
+	}
+}`)
+}
+
+func TestLiteralComments(t *testing.T) {
+	ctx, err := LoadFS(fstest.MapFS{
+		"schema/user.go": {Data: []byte(`package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+type User struct {
+	ent.Schema
+}
+
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("c"),
+		field.String("b").
+			// inner comment
+			Optional(),
+		// doc of a
+		field.String("a"), // trailing a
+	}
+}
+`)},
+	}, "schema/*.go")
+	require.NoError(t, err)
+	require.NoError(t, Mutate(ctx, &SortFields{}))
+	files, err := ctx.PrintFiles()
+	require.NoError(t, err)
+	require.Contains(t, string(files["user.go"]), `	return []ent.Field{
+		// doc of a
+		field.String("a"), // trailing a
+		field.String("b").
+			// inner comment
+			Optional(),
+		field.String("c"),
+	}`)
+
+	require.NoError(t, ctx.InsertField("User", field.Int("age").Descriptor(), 1))
+	files, err = ctx.PrintFiles()
+	require.NoError(t, err)
+	contents := string(files["user.go"])
+	require.Equal(t, 1, strings.Count(contents, "// inner comment"))
+	require.Contains(t, contents, `		field.String("a"), // trailing a
+		field.Int("age"),
+		field.String("b").`)
+}
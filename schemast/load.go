@@ -15,9 +15,19 @@
 package schemast
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
 
+	"entgo.io/ent/schema/field"
 	"golang.org/x/tools/go/packages"
 )
 
@@ -25,7 +35,61 @@ import (
 // can be analyzed an manipulated by different programs.
 type Context struct {
 	SchemaPackage *packages.Package
-	newTypes      map[string]*ast.File
+	// Packages holds the packages loaded along with the schema package using WithPackages, such as a package
+	// of shared mixins. They are used to resolve the references to their types (e.g., the mixins or the edge
+	// types of the schema package), but they are neither modified nor printed by the Context.
+	Packages []*packages.Package
+	// ReceiverStyle configures the receiver of the methods generated by the Context.
+	// Defaults to ReceiverTypeName.
+	ReceiverStyle ReceiverStyle
+	// ImportPaths maps the import paths of packages that declare the Go types of fields (e.g. types set
+	// with GoType) to the import paths used by the generated code, for example when the types are moved
+	// to another module. The package names must be the same.
+	ImportPaths map[string]string
+	// Lenient makes AppendField, and the mutators that use it such as UpsertSchema, drop the features of
+	// field descriptors that cannot be serialized (e.g. validators, default funcs or annotations without an
+	// Annotator) and report them as warnings (see Warnings), instead of failing.
+	Lenient  bool
+	newTypes map[string]*ast.File
+	path     string
+	// fsys and pattern are set for Contexts loaded with LoadFS.
+	fsys         fs.FS
+	pattern      string
+	loadOpts     *loadOpts
+	fieldLinters []FieldLinter
+	warnings     []string
+	// imported holds the packages imported by the schema package that were loaded to resolve their mixins,
+	// keyed by their import paths. Packages that could not be loaded are nil.
+	imported map[string]*packages.Package
+}
+
+// ReceiverStyle defines the form of the receiver of generated methods.
+type ReceiverStyle int
+
+const (
+	// ReceiverTypeName generates methods with an unnamed receiver, e.g. func (User) Fields().
+	ReceiverTypeName ReceiverStyle = iota
+	// ReceiverShortName generates methods with a receiver named after the lowercased
+	// first letter of the type name, e.g. func (u User) Fields().
+	ReceiverShortName
+)
+
+// FieldLinter inspects a field that is appended to the type typeName and returns warnings for suspicious
+// configurations. Warnings do not prevent the field from being appended.
+type FieldLinter func(typeName string, desc *field.Descriptor) []string
+
+// AddFieldLinter registers a FieldLinter that is invoked for every field appended to the Context.
+func (c *Context) AddFieldLinter(l FieldLinter) {
+	c.fieldLinters = append(c.fieldLinters, l)
+}
+
+// Warnings returns the warnings collected by the Context, in the order they were reported.
+func (c *Context) Warnings() []string {
+	return c.warnings
+}
+
+func (c *Context) warn(format string, args ...interface{}) {
+	c.warnings = append(c.warnings, fmt.Sprintf(format, args...))
 }
 
 // HasType reports whether typeName is already defined in the Context.
@@ -34,6 +98,51 @@ func (c *Context) HasType(typeName string) bool {
 	return ok
 }
 
+// hasTypeRef reports whether the type referenced by ref is declared, either in the schema package (e.g.
+// "User"), or in one of the Packages of the Context (e.g. "shared.SoftDelete").
+func (c *Context) hasTypeRef(ref string) bool {
+	i := strings.IndexByte(ref, '.')
+	if i == -1 {
+		return c.HasType(ref)
+	}
+	pkg, ok := c.lookupPackage(ref[:i])
+	if !ok {
+		return false
+	}
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			if gd, ok := decl.(*ast.GenDecl); ok && isTypeDeclFor(gd, ref[i+1:]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lookupPackage returns the package of the Packages of the Context named name.
+func (c *Context) lookupPackage(name string) (*packages.Package, bool) {
+	for _, pkg := range c.Packages {
+		if pkg.Name == name {
+			return pkg, true
+		}
+	}
+	return nil, false
+}
+
+// refImports returns the import paths of the Packages of the Context that are referenced by the qualified
+// type references refs, e.g. "shared.Like".
+func (c *Context) refImports(refs ...string) []string {
+	var paths []string
+	for _, ref := range refs {
+		if i := strings.IndexByte(ref, '.'); i != -1 {
+			if pkg, ok := c.lookupPackage(ref[:i]); ok {
+				paths = append(paths, pkg.PkgPath)
+			}
+		}
+	}
+	return paths
+}
+
 func (c *Context) lookupTypeDecl(typeName string) (*ast.File, *ast.GenDecl, bool) {
 	for _, file := range c.syntax() {
 		var (
@@ -92,32 +201,448 @@ func (c *Context) returnStmt(typeName, method string) (*ast.ReturnStmt, error) {
 	if !ok {
 		return nil, fmt.Errorf("schemast: could not find method %q for type %q", method, typeName)
 	}
-	if len(fd.Body.List) != 1 {
-		return nil, fmt.Errorf("schmeast: %s() func body must have a single element", method)
+	if len(fd.Body.List) == 0 {
+		return nil, fmt.Errorf("schemast: %s() func body must contain a return statement", method)
+	}
+	stmt, ok := fd.Body.List[len(fd.Body.List)-1].(*ast.ReturnStmt)
+	if !ok || len(stmt.Results) != 1 {
+		return nil, fmt.Errorf("schemast: %s() func body must end with a return statement", method)
+	}
+	switch r := stmt.Results[0].(type) {
+	case *ast.CompositeLit:
+		return stmt, nil
+	case *ast.Ident:
+		if r.Name == "nil" {
+			return stmt, nil
+		}
+	}
+	if id, ok := stmt.Results[0].(*ast.Ident); ok {
+		if pos, ok := reassignment(fd.Body, id.Name); ok {
+			return nil, fmt.Errorf("schemast: %s: the %s method of type %q reassigns the returned variable %s, "+
+				"expected a variable assigned a slice literal once", c.SchemaPackage.Fset.Position(pos), method, typeName, id.Name)
+		}
+	}
+	lit, ok := returnedSlice(fd.Body, stmt.Results[0])
+	if !ok {
+		return nil, fmt.Errorf("schemast: %s: the %s method of type %q returns an expression that is not supported, "+
+			"expected a slice literal, an append call whose last argument is a slice literal, or a variable assigned a slice literal",
+			c.SchemaPackage.Fset.Position(stmt.Results[0].Pos()), method, typeName)
+	}
+	// The returned statement is not part of the AST, but its result is the literal of the method body, such
+	// that changes to its elements are applied to the method.
+	return &ast.ReturnStmt{Return: stmt.Return, Results: []ast.Expr{lit}}, nil
+}
+
+// returnedSlice returns the slice literal that holds the elements of expr, the result of a method with the
+// given body. For example, the literal of the last argument of append(base(), []ent.Field{...}...), or the
+// literal assigned to the variable fields for fields := []ent.Field{...}.
+func returnedSlice(body *ast.BlockStmt, expr ast.Expr) (*ast.CompositeLit, bool) {
+	switch x := expr.(type) {
+	case *ast.CompositeLit:
+		return x, true
+	case *ast.CallExpr:
+		if id, ok := x.Fun.(*ast.Ident); !ok || id.Name != "append" || !x.Ellipsis.IsValid() {
+			return nil, false
+		}
+		lit, ok := x.Args[len(x.Args)-1].(*ast.CompositeLit)
+		return lit, ok
+	case *ast.Ident:
+		for _, stmt := range body.List {
+			switch stmt := stmt.(type) {
+			case *ast.AssignStmt:
+				if stmt.Tok == token.DEFINE && len(stmt.Lhs) == 1 && len(stmt.Rhs) == 1 {
+					if id, ok := stmt.Lhs[0].(*ast.Ident); ok && id.Name == x.Name {
+						return returnedSlice(body, stmt.Rhs[0])
+					}
+				}
+			case *ast.DeclStmt:
+				gd, ok := stmt.Decl.(*ast.GenDecl)
+				if !ok || gd.Tok != token.VAR {
+					continue
+				}
+				for _, spec := range gd.Specs {
+					vs, ok := spec.(*ast.ValueSpec)
+					if ok && len(vs.Names) == 1 && len(vs.Values) == 1 && vs.Names[0].Name == x.Name {
+						return returnedSlice(body, vs.Values[0])
+					}
+				}
+			}
+		}
 	}
-	if _, ok := fd.Body.List[0].(*ast.ReturnStmt); !ok {
-		return nil, fmt.Errorf("schmeast: %s() func body must contain a return statement", method)
+	return nil, false
+}
+
+// reassignment returns the position of the first assignment to the variable name in body after the one that
+// declares it, e.g. fields = append(fields, ...) after fields := []ent.Field{...}.
+func reassignment(body *ast.BlockStmt, name string) (token.Pos, bool) {
+	var (
+		pos   token.Pos
+		found int
+	)
+	assigned := func(expr ast.Expr) {
+		if id, ok := expr.(*ast.Ident); ok && id.Name == name {
+			if found++; found == 2 {
+				pos = id.Pos()
+			}
+		}
 	}
-	return fd.Body.List[0].(*ast.ReturnStmt), nil
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				assigned(lhs)
+			}
+		case *ast.ValueSpec:
+			for _, id := range n.Names {
+				assigned(id)
+			}
+		}
+		return found < 2
+	})
+	return pos, found >= 2
 }
 
-// Load loads a *schemast.Context from a path.
-func Load(path string) (*Context, error) {
-	pkgs, err := packages.Load(&packages.Config{
-		Mode: packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax,
-	}, path)
+// Load loads a *schemast.Context from a path. Load receives functional options of type LoadOption that modify
+// its behavior.
+func Load(path string, opts ...LoadOption) (*Context, error) {
+	options := &loadOpts{}
+	for _, apply := range opts {
+		apply(options)
+	}
+	pkg, err := loadPackage(path, options)
 	if err != nil {
-		return nil, fmt.Errorf("loading package: %w", err)
+		return nil, err
 	}
-	if len(pkgs) < 1 {
-		return nil, fmt.Errorf("missing package information for: %s", path)
+	extra, err := loadPackages(options)
+	if err != nil {
+		return nil, err
 	}
 	return &Context{
-		SchemaPackage: pkgs[0],
+		SchemaPackage: pkg,
+		Packages:      extra,
 		newTypes:      make(map[string]*ast.File),
+		path:          path,
+		loadOpts:      options,
 	}, nil
 }
 
+// LoadOption modifies the behavior of Load.
+type LoadOption func(*loadOpts)
+
+type loadOpts struct {
+	overlay    map[string][]byte
+	buildFlags []string
+	env        []string
+	dir        string
+	tests      bool
+	syntaxOnly bool
+	packages   []string
+	cache      *Cache
+}
+
+// WithOverlay modifies Load to read the contents of the files in overlay from memory instead of the disk,
+// like the Overlay of packages.Config. The keys of overlay are file paths, and relative paths are resolved
+// from the working directory. Files of the overlay that do not exist on disk are added to the package of
+// their directory, which allows loading the unsaved buffers of an editor.
+func WithOverlay(overlay map[string][]byte) LoadOption {
+	return func(opts *loadOpts) {
+		opts.overlay = overlay
+	}
+}
+
+// LoadFS loads a *schemast.Context from the .go files of fsys that match pattern (see fs.Glob), e.g. an
+// embed.FS holding schema templates. The files are parsed, but not type-checked, and must belong to the same
+// package. When printed, the files keep their base names.
+func LoadFS(fsys fs.FS, pattern string) (*Context, error) {
+	pkg, err := parseFS(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &Context{
+		SchemaPackage: pkg,
+		newTypes:      make(map[string]*ast.File),
+		fsys:          fsys,
+		pattern:       pattern,
+	}, nil
+}
+
+func parseFS(fsys fs.FS, pattern string) (*packages.Package, error) {
+	matches, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+	pkg := &packages.Package{Fset: token.NewFileSet()}
+	for _, name := range matches {
+		if path.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		src, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parser.ParseFile(pkg.Fset, name, src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if pkg.Name != "" && f.Name.Name != pkg.Name {
+			return nil, fmt.Errorf("schemast: found packages %s and %s in %s", pkg.Name, f.Name.Name, pattern)
+		}
+		pkg.Name = f.Name.Name
+		pkg.Syntax = append(pkg.Syntax, f)
+	}
+	if len(pkg.Syntax) == 0 {
+		return nil, fmt.Errorf("schemast: no .go files match %s", pattern)
+	}
+	return pkg, nil
+}
+
+// Reload re-parses the schema package of the Context from the path (or the fs.FS) it was loaded from, replacing
+// the in-memory ASTs. Any mutation that was not written to disk (using Print) before calling
+// Reload is discarded, including types added with AddType.
+func (c *Context) Reload() error {
+	var (
+		pkg *packages.Package
+		err error
+	)
+	if c.fsys != nil {
+		pkg, err = parseFS(c.fsys, c.pattern)
+	} else {
+		pkg, err = loadPackage(c.path, c.loadOpts)
+	}
+	if err != nil {
+		return err
+	}
+	if c.loadOpts != nil {
+		if c.Packages, err = loadPackages(c.loadOpts); err != nil {
+			return err
+		}
+	}
+	c.SchemaPackage = pkg
+	c.newTypes = make(map[string]*ast.File)
+	return nil
+}
+
+// WithBuildFlags modifies Load to pass flags to the build system, such as "-tags=integration" for schemas
+// guarded by build constraints.
+func WithBuildFlags(flags ...string) LoadOption {
+	return func(opts *loadOpts) {
+		opts.buildFlags = append(opts.buildFlags, flags...)
+	}
+}
+
+// WithEnv modifies Load to run the build system with env, a list of "KEY=value" pairs, added to the
+// environment of the current process, e.g. GOFLAGS or GOOS.
+func WithEnv(env ...string) LoadOption {
+	return func(opts *loadOpts) {
+		opts.env = append(opts.env, env...)
+	}
+}
+
+// WithDir modifies Load to resolve the path to load from dir instead of the working directory, e.g. for schemas
+// that belong to another module.
+func WithDir(dir string) LoadOption {
+	return func(opts *loadOpts) {
+		opts.dir = dir
+	}
+}
+
+// WithTests modifies Load to include the _test.go files of the schema package that belong to the package.
+// External test packages (package schema_test) are ignored.
+func WithTests() LoadOption {
+	return func(opts *loadOpts) {
+		opts.tests = true
+	}
+}
+
+// SyntaxOnly modifies Load to only parse the files of the schema package, without type-checking them or
+// loading the dependencies of the package, which is considerably faster for tools that only edit the
+// schema. The Types and TypesInfo of the loaded packages are not set.
+func SyntaxOnly() LoadOption {
+	return func(opts *loadOpts) {
+		opts.syntaxOnly = true
+	}
+}
+
+// WithPackages modifies Load to also load the packages at paths into the Packages of the Context, such that
+// the schema package can reference their types. The types of a package are referenced using its name, e.g.
+// "shared.SoftDelete" for the SoftDelete type of a package named shared.
+func WithPackages(paths ...string) LoadOption {
+	return func(opts *loadOpts) {
+		opts.packages = append(opts.packages, paths...)
+	}
+}
+
+func loadPackages(opts *loadOpts) ([]*packages.Package, error) {
+	var pkgs []*packages.Package
+	for _, path := range opts.packages {
+		pkg, err := loadPackage(path, &loadOpts{
+			overlay:    opts.overlay,
+			buildFlags: opts.buildFlags,
+			env:        opts.env,
+			dir:        opts.dir,
+			syntaxOnly: opts.syntaxOnly,
+			cache:      opts.cache,
+		})
+		if err != nil {
+			return nil, err
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	return pkgs, nil
+}
+
+func loadPackage(path string, opts *loadOpts) (*packages.Package, error) {
+	if opts.cache != nil {
+		return opts.cache.load(path, opts)
+	}
+	return listPackage(path, opts)
+}
+
+// listPackage loads the package at path using the go command.
+func listPackage(path string, opts *loadOpts) (*packages.Package, error) {
+	cfg := &packages.Config{
+		Mode:       packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax,
+		BuildFlags: opts.buildFlags,
+		Dir:        opts.dir,
+		Tests:      opts.tests,
+	}
+	if opts.syntaxOnly {
+		// The Fset of the packages is only set when their types are loaded.
+		cfg.Mode = packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedSyntax
+		cfg.Fset = token.NewFileSet()
+	}
+	if len(opts.env) > 0 {
+		cfg.Env = append(os.Environ(), opts.env...)
+	}
+	if len(opts.overlay) > 0 {
+		cfg.Overlay = make(map[string][]byte, len(opts.overlay))
+		for name, src := range opts.overlay {
+			abs, err := filepath.Abs(name)
+			if err != nil {
+				return nil, err
+			}
+			cfg.Overlay[abs] = src
+		}
+	}
+	pkgs, err := packages.Load(cfg, path)
+	if err != nil {
+		return nil, fmt.Errorf("loading package: %w", err)
+	}
+	if len(pkgs) < 1 {
+		return nil, fmt.Errorf("missing package information for: %s", path)
+	}
+	for _, pkg := range pkgs {
+		if pkg.Fset == nil {
+			pkg.Fset = cfg.Fset
+		}
+	}
+	if opts.tests {
+		// The package compiled with its _test.go files has an ID of the form "path [path.test]".
+		for _, pkg := range pkgs {
+			if strings.HasSuffix(pkg.ID, ".test]") && pkg.Name == pkgs[0].Name {
+				return pkg, nil
+			}
+		}
+	}
+	return pkgs[0], nil
+}
+
+// Clone returns a deep copy of the Context, such that mutations can be tried on the copy (e.g. printed and
+// compiled) and discarded without reloading the schema package. The files of the Context are copied to a
+// new token.FileSet. The TypesInfo of the copied SchemaPackage is not set, as it refers to the syntax trees
+// of the Context. The Packages of the Context are shared, as they are not modified.
+func (c *Context) Clone() (*Context, error) {
+	fset := token.NewFileSet()
+	files := make(map[*ast.File]*ast.File)
+	clone := func(f *ast.File) (*ast.File, error) {
+		if cloned, ok := files[f]; ok {
+			return cloned, nil
+		}
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, c.SchemaPackage.Fset, f); err != nil {
+			return nil, err
+		}
+		cloned, err := parser.ParseFile(fset, c.SchemaPackage.Fset.File(f.Pos()).Name(), buf.Bytes(), parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files[f] = cloned
+		return cloned, nil
+	}
+	pkg := *c.SchemaPackage
+	pkg.Fset = fset
+	pkg.TypesInfo = nil
+	pkg.Syntax = make([]*ast.File, len(c.SchemaPackage.Syntax))
+	for i, f := range c.SchemaPackage.Syntax {
+		cloned, err := clone(f)
+		if err != nil {
+			return nil, err
+		}
+		pkg.Syntax[i] = cloned
+	}
+	cc := *c
+	cc.SchemaPackage = &pkg
+	cc.Packages = append([]*packages.Package(nil), c.Packages...)
+	cc.newTypes = make(map[string]*ast.File, len(c.newTypes))
+	for typeName, f := range c.newTypes {
+		cloned, err := clone(f)
+		if err != nil {
+			return nil, err
+		}
+		cc.newTypes[typeName] = cloned
+	}
+	if c.ImportPaths != nil {
+		cc.ImportPaths = make(map[string]string, len(c.ImportPaths))
+		for path, to := range c.ImportPaths {
+			cc.ImportPaths[path] = to
+		}
+	}
+	cc.imported = make(map[string]*packages.Package, len(c.imported))
+	for path, pkg := range c.imported {
+		cc.imported[path] = pkg
+	}
+	cc.fieldLinters = append([]FieldLinter(nil), c.fieldLinters...)
+	cc.warnings = append([]string(nil), c.warnings...)
+	return &cc, nil
+}
+
+// Snapshot holds the state of a Context at the time it was taken with Context.Snapshot.
+type Snapshot struct {
+	ctx *Context
+}
+
+// Snapshot returns a Snapshot of the current state of the Context, that can be restored with Restore, for
+// example to roll back a batch of mutations when one of them fails:
+//
+//	snap, err := ctx.Snapshot()
+//	if err != nil {
+//		return err
+//	}
+//	if err := schemast.Mutate(ctx, mutations...); err != nil {
+//		if rerr := ctx.Restore(snap); rerr != nil {
+//			return rerr
+//		}
+//		return err
+//	}
+func (c *Context) Snapshot() (*Snapshot, error) {
+	cc, err := c.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{ctx: cc}, nil
+}
+
+// Restore reverts the Context to the state held by snap. A Snapshot can be restored several times. As with
+// Clone, the restored SchemaPackage is a copy with its own token.FileSet, and its TypesInfo is not set.
+func (c *Context) Restore(snap *Snapshot) error {
+	cc, err := snap.ctx.Clone()
+	if err != nil {
+		return err
+	}
+	*c = *cc
+	return nil
+}
+
 func (c *Context) syntax() []*ast.File {
 	var out []*ast.File
 	out = append(out, c.SchemaPackage.Syntax...)
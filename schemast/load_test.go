@@ -17,8 +17,14 @@ package schemast
 import (
 	"bytes"
 	"go/printer"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"testing/fstest"
 
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
 	"github.com/stretchr/testify/require"
 )
 
@@ -40,3 +46,197 @@ func (Message) Fields() []ent.Field {
 	return nil
 }`, buf.String())
 }
+
+func TestContext_Reload(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.print())
+	ctx, err := Load(tt.schemaDir())
+	require.NoError(t, err)
+	require.False(t, ctx.HasField("Message", "title"))
+	require.NoError(t, ctx.AddType("Pending"))
+
+	fn := filepath.Join(tt.schemaDir(), "message.go")
+	contents := strings.Replace(tt.contents("message.go"), `func (Message) Fields() []ent.Field {
+	return nil
+}`, `func (Message) Fields() []ent.Field {
+	return []ent.Field{field.String("title")}
+}`, 1)
+	contents = strings.Replace(contents, `"entgo.io/ent/schema"`, `"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"`, 1)
+	require.NoError(t, os.WriteFile(fn, []byte(contents), 0600))
+
+	require.NoError(t, ctx.Reload())
+	require.True(t, ctx.HasField("Message", "title"))
+	require.False(t, ctx.HasType("Pending"))
+}
+
+func TestLoadFS(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	fsys := fstest.MapFS{
+		"schema/user.go": {Data: []byte(`package schema
+
+import "entgo.io/ent"
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return nil
+}
+`)},
+		"schema/README.md":    {Data: []byte("# Schema")},
+		"schema/user_test.go": {Data: []byte("package schema_test")},
+		"other/message.go":    {Data: []byte("package other")},
+		"schema/invalid/x.go": {Data: []byte("package invalid")},
+	}
+	ctx, err := LoadFS(fsys, "schema/*")
+	require.NoError(t, err)
+	require.Len(t, ctx.SchemaPackage.Syntax, 1)
+	require.True(t, ctx.HasType("User"))
+	require.NoError(t, ctx.AppendField("User", field.String("name").Descriptor()))
+	require.NoError(t, ctx.AddType("Message"))
+	tt.ctx = ctx
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	require.Len(t, tt.getType("User").Fields, 1)
+	require.Contains(t, tt.contents("user.go"), "// Fields of the User.\nfunc (User) Fields() []ent.Field {")
+
+	require.NoError(t, ctx.Reload())
+	require.False(t, ctx.HasField("User", "name"))
+	require.False(t, ctx.HasType("Message"))
+
+	_, err = LoadFS(fsys, "*/*.go")
+	require.EqualError(t, err, "schemast: found packages other and schema in */*.go")
+	_, err = LoadFS(fsys, "none/*.go")
+	require.EqualError(t, err, "schemast: no .go files match none/*.go")
+}
+
+func TestLoadOverlay(t *testing.T) {
+	dir := "./internal/printtest/ent/schema"
+	message, err := os.ReadFile(filepath.Join(dir, "message.go"))
+	require.NoError(t, err)
+	edited := strings.Replace(string(message), `func (Message) Fields() []ent.Field {
+	return nil
+}`, `func (Message) Fields() []ent.Field {
+	return []ent.Field{field.String("title")}
+}`, 1)
+	edited = strings.Replace(edited, `"entgo.io/ent/schema"`, `"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"`, 1)
+	ctx, err := Load(dir, WithOverlay(map[string][]byte{
+		filepath.Join(dir, "message.go"): []byte(edited),
+		filepath.Join(dir, "pet.go"): []byte(`package schema
+
+import "entgo.io/ent"
+
+type Pet struct {
+	ent.Schema
+}
+`),
+	}))
+	require.NoError(t, err)
+	require.True(t, ctx.HasField("Message", "title"))
+	require.True(t, ctx.HasType("Pet"))
+	require.NoError(t, ctx.Reload())
+	require.True(t, ctx.HasType("Pet"))
+
+	ctx, err = Load(dir)
+	require.NoError(t, err)
+	require.False(t, ctx.HasField("Message", "title"))
+	require.False(t, ctx.HasType("Pet"))
+}
+
+func TestLoadOptions(t *testing.T) {
+	dir := "./internal/loadtest/ent/schema"
+	ctx, err := Load(dir)
+	require.NoError(t, err)
+	require.True(t, ctx.HasType("Message"))
+	require.False(t, ctx.HasType("Pet"))
+	require.False(t, ctx.HasType("Fixture"))
+
+	ctx, err = Load(dir, WithBuildFlags("-tags=schemast_pet"))
+	require.NoError(t, err)
+	require.True(t, ctx.HasType("Pet"))
+	require.NoError(t, ctx.Reload())
+	require.True(t, ctx.HasType("Pet"))
+
+	ctx, err = Load(dir, WithEnv("GOFLAGS=-tags=schemast_pet"))
+	require.NoError(t, err)
+	require.True(t, ctx.HasType("Pet"))
+
+	ctx, err = Load(".", WithDir(dir), WithTests())
+	require.NoError(t, err)
+	require.True(t, ctx.HasType("Message"))
+	require.True(t, ctx.HasType("Fixture"))
+	require.False(t, ctx.HasType("Pet"))
+}
+
+func TestLoadSyntaxOnly(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	tt.ctx, err = Load("./internal/printtest/ent/schema", SyntaxOnly(), WithPackages("./internal/sharedtest"))
+	require.NoError(t, err)
+	require.Nil(t, tt.ctx.SchemaPackage.Types)
+	require.Nil(t, tt.ctx.SchemaPackage.TypesInfo)
+	require.Nil(t, tt.ctx.Packages[0].Types)
+	require.True(t, tt.ctx.HasType("User"))
+	require.NoError(t, tt.ctx.AddMixin("User", "sharedtest.SoftDelete{}"))
+	require.NoError(t, tt.ctx.AppendField("User", field.String("name").Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	require.Len(t, tt.getType("User").Fields, 2)
+}
+
+func TestContext_Clone(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddType("Pet"))
+	tt.ctx.ImportPaths = map[string]string{"a": "b"}
+	clone, err := tt.ctx.Clone()
+	require.NoError(t, err)
+	require.NotSame(t, tt.ctx.SchemaPackage.Fset, clone.SchemaPackage.Fset)
+	require.NoError(t, clone.AppendField("User", field.String("name").Descriptor()))
+	require.NoError(t, clone.AppendField("Pet", field.String("name").Descriptor()))
+	require.NoError(t, clone.AddType("Group"))
+	clone.ImportPaths["a"] = "c"
+	require.False(t, tt.ctx.HasField("User", "name"))
+	require.False(t, tt.ctx.HasField("Pet", "name"))
+	require.False(t, tt.ctx.HasType("Group"))
+	require.Equal(t, "b", tt.ctx.ImportPaths["a"])
+
+	require.NoError(t, tt.ctx.RemoveType("Message"))
+	require.True(t, clone.HasType("Message"))
+	tt.ctx = clone
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	require.Len(t, tt.getType("User").Fields, 1)
+	require.Len(t, tt.getType("Pet").Fields, 1)
+	require.NotNil(t, tt.getType("Group"))
+}
+
+func TestContext_Snapshot(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	snap, err := tt.ctx.Snapshot()
+	require.NoError(t, err)
+	err = Mutate(tt.ctx,
+		&UpsertSchema{Name: "Pet", Fields: []ent.Field{field.String("name")}},
+		&UpsertSchema{Name: "Group", Fields: []ent.Field{field.String("name").DefaultFunc(func() string { return "" })}},
+	)
+	require.Error(t, err)
+	require.True(t, tt.ctx.HasType("Pet"))
+	require.NoError(t, tt.ctx.Restore(snap))
+	require.False(t, tt.ctx.HasType("Pet"))
+	require.False(t, tt.ctx.HasType("Group"))
+
+	require.NoError(t, tt.ctx.AddType("Pet"))
+	require.NoError(t, tt.ctx.Restore(snap))
+	require.False(t, tt.ctx.HasType("Pet"))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	require.Nil(t, tt.getType("Pet"))
+}
@@ -0,0 +1,326 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/inflect"
+	"golang.org/x/tools/go/packages"
+)
+
+// AddMixinType adds a new mixin type named typeName to the Context. The type embeds mixin.Schema and declares
+// a Fields method returning nil, to which fields can be appended using AppendField.
+func (c *Context) AddMixinType(typeName string) error {
+	if c.HasType(typeName) {
+		return fmt.Errorf("schemast: type %q already exists", typeName)
+	}
+	recv := typeName
+	if name := c.receiverName(typeName); name != "" {
+		recv = name + " " + typeName
+	}
+	pkgName := c.SchemaPackage.Name
+	if pkgName == "" {
+		// The package has no files yet.
+		pkgName = "schema"
+	}
+	if c.SchemaPackage.Fset == nil {
+		c.SchemaPackage.Fset = token.NewFileSet()
+	}
+	body := fmt.Sprintf(`package %[3]s
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+type %[1]s struct {
+	mixin.Schema
+}
+func (%[2]s) Fields() []ent.Field {
+	return nil
+}
+`, typeName, recv, pkgName)
+	fn := inflect.Underscore(typeName) + ".go"
+	f, err := parser.ParseFile(c.SchemaPackage.Fset, fn, body, 0)
+	if err != nil {
+		return err
+	}
+	c.newTypes[typeName] = f
+	return nil
+}
+
+// AddMixin adds a mixin to the returned values of the Mixin method of type typeName. The mixin is the source of
+// a Go expression, e.g. "mixin.Time{}" or "SoftDeleteMixin{}". A type name, such as "mixin.Time", is expanded
+// to an empty composite literal of the type. Mixins of the ent mixin package are imported by the file of the
+// Mixin method.
+func (c *Context) AddMixin(typeName, mixin string) error {
+	expr, err := mixinExpr(mixin)
+	if err != nil {
+		return err
+	}
+	name := mixinName(expr)
+	for _, m := range c.mixins(typeName) {
+		if mixinName(m) == name {
+			return fmt.Errorf("schemast: type %q already declares mixin %q", typeName, name)
+		}
+	}
+	if _, err := c.appendReturnItem(kindMixin, typeName, expr); err != nil {
+		return err
+	}
+	if strings.HasPrefix(name, "mixin.") {
+		c.appendImport(c.methodFile(typeName, kindMixin.methodName), "entgo.io/ent/schema/mixin")
+	}
+	c.appendImports(c.methodFile(typeName, kindMixin.methodName), c.refImports(name))
+	return nil
+}
+
+// RemoveMixin removes the mixin of the type named mixin (e.g. "mixin.Time") from the returned values of the
+// Mixin method of type typeName.
+func (c *Context) RemoveMixin(typeName, mixin string) error {
+	stmt, err := c.returnStmt(typeName, kindMixin.methodName)
+	if err != nil {
+		return err
+	}
+	if returned, ok := stmt.Results[0].(*ast.CompositeLit); ok {
+		for i, item := range returned.Elts {
+			if mixinName(item) == mixin {
+				returned.Elts = append(returned.Elts[:i], returned.Elts[i+1:]...)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("schemast: could not find mixin %q in type %q", mixin, typeName)
+}
+
+// mixins returns the expressions returned by the Mixin method of type typeName, if it declares one.
+func (c *Context) mixins(typeName string) []ast.Expr {
+	if _, ok := c.lookupMethod(typeName, kindMixin.methodName); !ok {
+		return nil
+	}
+	stmt, err := c.returnStmt(typeName, kindMixin.methodName)
+	if err != nil {
+		return nil
+	}
+	if returned, ok := stmt.Results[0].(*ast.CompositeLit); ok {
+		return returned.Elts
+	}
+	return nil
+}
+
+// mixinExpr parses the source of a mixin expression, expanding type names to empty composite literals.
+func mixinExpr(src string) (ast.Expr, error) {
+	expr, err := parser.ParseExpr(src)
+	if err != nil {
+		return nil, fmt.Errorf("schemast: invalid mixin expression %q: %w", src, err)
+	}
+	clearPos(expr)
+	switch expr.(type) {
+	case *ast.Ident, *ast.SelectorExpr:
+		return structLit(expr), nil
+	}
+	return expr, nil
+}
+
+// mixinName returns the name of the type of a mixin expression, e.g. "mixin.Time" for mixin.Time{}, or the
+// name of the function that creates it, e.g. "mixin.AnnotateFields" for mixin.AnnotateFields(m, annots...).
+func mixinName(expr ast.Expr) string {
+	if call, ok := expr.(*ast.CallExpr); ok {
+		expr = call.Fun
+	} else {
+		expr = compositeType(expr)
+	}
+	name, err := exprString(expr)
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+// compositeType returns the type of a composite literal, or of a pointer to one, e.g. T for &T{}.
+func compositeType(expr ast.Expr) ast.Expr {
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		expr = u.X
+	}
+	if lit, ok := expr.(*ast.CompositeLit); ok {
+		return lit.Type
+	}
+	return expr
+}
+
+// mixinField returns the declaration of the field named fieldName in the Fields method of one of the mixins
+// of type typeName. The mixins declared in the schema package, in the Packages of the Context and in the
+// packages imported by the schema package (e.g. entgo.io/ent/schema/mixin) are resolved. The imported
+// packages are loaded the first time they are needed, only if the Context was loaded from disk.
+func (c *Context) mixinField(typeName, fieldName string) (*ast.CallExpr, bool) {
+	file := c.methodFile(typeName, kindMixin.methodName)
+	for _, expr := range c.mixins(typeName) {
+		name := mixinName(expr)
+		files := c.syntax()
+		if i := strings.IndexByte(name, '.'); i != -1 {
+			pkg, ok := c.mixinPackage(file, name[:i])
+			if !ok {
+				continue
+			}
+			files, name = pkg.Syntax, name[i+1:]
+		}
+		for _, call := range declaredFields(files, name, make(map[string]bool)) {
+			if n, err := extractFieldName(call); err == nil && n == fieldName {
+				return call, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// mixinPackage returns the package referenced by the qualifier name in file, either one of the Packages of
+// the Context, or a package imported by file. Imported packages are matched by their name, which may differ
+// from the last element of their import path, and the ones that cannot be loaded are reported as warnings.
+func (c *Context) mixinPackage(file *ast.File, name string) (*packages.Package, bool) {
+	if pkg, ok := c.lookupPackage(name); ok {
+		return pkg, true
+	}
+	if file == nil || c.fsys != nil {
+		return nil, false
+	}
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		if spec.Name != nil && spec.Name.Name != name || spec.Name == nil && c.importName(path) != name {
+			continue
+		}
+		pkg, ok := c.imported[path]
+		if !ok {
+			pkg = c.loadImported(file, path)
+		}
+		if pkg != nil && (spec.Name != nil || pkg.Name == name) {
+			return pkg, true
+		}
+	}
+	return nil, false
+}
+
+// loadImported loads the syntax of the package with the import path path imported by file, and caches it in
+// the Context. Failures are reported as warnings, and cached as nil packages to be reported only once.
+func (c *Context) loadImported(file *ast.File, path string) *packages.Package {
+	opts := &loadOpts{
+		syntaxOnly: true,
+		dir:        filepath.Dir(c.SchemaPackage.Fset.File(file.Pos()).Name()),
+	}
+	if c.loadOpts != nil {
+		opts.buildFlags, opts.env = c.loadOpts.buildFlags, c.loadOpts.env
+	}
+	pkg, err := loadPackage(path, opts)
+	switch {
+	case err != nil:
+	case len(pkg.Errors) > 0:
+		err = pkg.Errors[0]
+	case len(pkg.Syntax) == 0:
+		err = errors.New("no Go files")
+	}
+	if err != nil {
+		c.warn("could not load package %q to resolve its mixins: %v", path, err)
+		pkg = nil
+	}
+	if c.imported == nil {
+		c.imported = make(map[string]*packages.Package)
+	}
+	c.imported[path] = pkg
+	return pkg
+}
+
+// declaredFields returns the field declarations returned by the Fields method of the type typeName declared
+// in files. The method may return a slice literal, or append the results of the Fields methods of other types
+// of files, e.g. append(CreateTime{}.Fields(), UpdateTime{}.Fields()...). Types without a Fields method
+// have the fields of the types they embed.
+func declaredFields(files []*ast.File, typeName string, seen map[string]bool) []*ast.CallExpr {
+	if seen[typeName] {
+		return nil
+	}
+	seen[typeName] = true
+	var (
+		fields []*ast.CallExpr
+		eval   func(ast.Expr)
+	)
+	eval = func(expr ast.Expr) {
+		switch x := expr.(type) {
+		case *ast.CompositeLit:
+			for _, elt := range x.Elts {
+				if call, ok := elt.(*ast.CallExpr); ok {
+					fields = append(fields, call)
+				}
+			}
+		case *ast.CallExpr:
+			if id, ok := x.Fun.(*ast.Ident); ok && id.Name == "append" {
+				for _, arg := range x.Args {
+					eval(arg)
+				}
+				return
+			}
+			if sel, ok := x.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Fields" {
+				if id, ok := compositeType(sel.X).(*ast.Ident); ok {
+					fields = append(fields, declaredFields(files, id.Name, seen)...)
+				}
+			}
+		}
+	}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || len(fd.Recv.List) == 0 || fd.Name.Name != "Fields" || fd.Body == nil {
+				continue
+			}
+			if recvTypeName(fd.Recv.List[0].Type) != typeName {
+				continue
+			}
+			for _, stmt := range fd.Body.List {
+				if ret, ok := stmt.(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+					eval(ret.Results[0])
+				}
+			}
+			return fields
+		}
+	}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || !isTypeDeclFor(gd, typeName) {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok || ts.Name.Name != typeName {
+					continue
+				}
+				if st, ok := ts.Type.(*ast.StructType); ok {
+					for _, fld := range st.Fields.List {
+						if id, ok := fld.Type.(*ast.Ident); ok && len(fld.Names) == 0 {
+							fields = append(fields, declaredFields(files, id.Name, seen)...)
+						}
+					}
+				}
+			}
+		}
+	}
+	return fields
+}
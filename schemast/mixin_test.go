@@ -0,0 +1,165 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMixins(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddMixinType("SoftDeleteMixin"))
+	require.EqualError(t, tt.ctx.AddMixinType("User"), `schemast: type "User" already exists`)
+	require.NoError(t, tt.ctx.AppendField("SoftDeleteMixin", field.Time("deleted_at").Optional().Descriptor()))
+	require.NoError(t, tt.ctx.AddMixin("User", "SoftDeleteMixin"))
+	require.NoError(t, tt.ctx.AddMixin("User", "mixin.Time{}"))
+	require.NoError(t, tt.ctx.AddMixin("User", "&mixin.ID{}"))
+	require.NoError(t, tt.ctx.AddMixin("Message", "SoftDeleteMixin{}"))
+	require.EqualError(t, tt.ctx.AddMixin("User", "mixin.Time"), `schemast: type "User" already declares mixin "mixin.Time"`)
+	require.Error(t, tt.ctx.AddMixin("User", "mixin."))
+	require.NoError(t, tt.ctx.RemoveMixin("User", "mixin.ID"))
+	require.EqualError(t, tt.ctx.RemoveMixin("User", "mixin.ID"), `schemast: could not find mixin "mixin.ID" in type "User"`)
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `"entgo.io/ent/schema/mixin"`)
+	require.Contains(t, tt.contents("soft_delete_mixin.go"), "mixin.Schema")
+	require.Nil(t, tt.getType("SoftDeleteMixin"))
+	var fields []string
+	for _, f := range tt.getType("User").Fields {
+		fields = append(fields, f.Name)
+	}
+	require.EqualValues(t, []string{"deleted_at", "create_time", "update_time"}, fields)
+	require.Len(t, tt.getType("Message").Fields, 1)
+}
+
+func TestMixinsPackages(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	tt.ctx, err = Load("./internal/printtest/ent/schema", WithPackages("./internal/sharedtest"))
+	require.NoError(t, err)
+	require.Len(t, tt.ctx.Packages, 1)
+	require.NoError(t, tt.ctx.Reload())
+	require.Len(t, tt.ctx.Packages, 1)
+	require.NoError(t, tt.ctx.AddMixin("User", "sharedtest.SoftDelete{}"))
+	for _, e := range []ent.Edge{
+		WithType(edge.To("likes", placeholder.Type), "sharedtest.Like"),
+		WithType(edge.To("dislikes", placeholder.Type), "sharedtest.Dislike"),
+		WithType(edge.To("votes", placeholder.Type), "other.Vote"),
+	} {
+		require.NoError(t, tt.ctx.AppendEdge("Message", e.Descriptor()))
+	}
+	diags, err := tt.ctx.CheckEdges()
+	require.NoError(t, err)
+	var msgs []string
+	for _, d := range diags {
+		msgs = append(msgs, d.Type+"."+d.Edge+": "+d.Message)
+	}
+	require.EqualValues(t, []string{
+		`Message.dislikes: type "sharedtest.Dislike" is not declared`,
+		`Message.votes: type "other.Vote" is not declared`,
+	}, msgs)
+	require.NoError(t, tt.ctx.RemoveEdge("Message", "dislikes"))
+	require.NoError(t, tt.ctx.RemoveEdge("Message", "votes"))
+	require.NoError(t, tt.ctx.RemoveEdge("Message", "likes"))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `"entgo.io/contrib/schemast/internal/sharedtest"`)
+	var fields []string
+	for _, f := range tt.getType("User").Fields {
+		fields = append(fields, f.Name)
+	}
+	require.EqualValues(t, []string{"deleted_at"}, fields)
+}
+
+func TestMixinFields(t *testing.T) {
+	ctx, err := Load("./internal/printtest/ent/schema", WithPackages("./internal/sharedtest"))
+	require.NoError(t, err)
+	require.NoError(t, ctx.AddMixinType("Base"))
+	require.NoError(t, ctx.AppendField("Base", field.Int("owner_id").Optional().Descriptor()))
+	require.NoError(t, ctx.AddMixin("Message", "Base"))
+	require.NoError(t, ctx.AddMixin("User", "sharedtest.SoftDelete"))
+	require.NoError(t, ctx.AddMixin("User", "mixin.Time"))
+	require.True(t, ctx.HasField("Message", "owner_id"))
+	require.True(t, ctx.HasField("User", "deleted_at"))
+	require.True(t, ctx.HasField("User", "create_time"))
+	require.True(t, ctx.HasField("User", "update_time"))
+	require.False(t, ctx.HasField("User", "owner_id"))
+	require.False(t, ctx.HasField("Message", "deleted_at"))
+
+	for _, e := range []ent.Edge{
+		WithType(edge.To("owner", placeholder.Type).Unique().Field("owner_id"), "User"),
+		WithType(edge.To("other", placeholder.Type).Unique().Field("other_id"), "User"),
+	} {
+		require.NoError(t, ctx.AppendEdge("Message", e.Descriptor()))
+	}
+	diags, err := ctx.CheckEdges()
+	require.NoError(t, err)
+	require.Len(t, diags, 1)
+	require.Equal(t, `bound field "other_id" is not declared`, diags[0].Message)
+}
+
+func TestMixinFieldsPackageName(t *testing.T) {
+	dir, err := os.MkdirTemp(".", "mixintest-")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "schema.go"), []byte(`package schema
+
+import (
+	"entgo.io/contrib/schemast/internal/missing"
+	"entgo.io/contrib/schemast/internal/sharedtest/v2"
+	"entgo.io/ent"
+)
+
+type User struct {
+	ent.Schema
+}
+
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		sharedtest.Audit{},
+	}
+}
+
+type Pet struct {
+	ent.Schema
+}
+
+func (Pet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		missing.Mixin{},
+	}
+}
+`), 0600))
+	ctx, err := Load(dir)
+	require.NoError(t, err)
+	require.True(t, ctx.HasField("User", "audited_by"))
+	require.Empty(t, ctx.Warnings())
+	require.False(t, ctx.HasField("Pet", "name"))
+	require.False(t, ctx.HasField("Pet", "name"))
+	require.Len(t, ctx.Warnings(), 1)
+	require.Contains(t, ctx.Warnings()[0], `could not load package "entgo.io/contrib/schemast/internal/missing" to resolve its mixins`)
+}
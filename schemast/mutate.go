@@ -15,12 +15,21 @@
 package schemast
 
 import (
+	"bytes"
+	"fmt"
 	"go/ast"
+	"go/printer"
 	"go/token"
+	"sort"
+	"strconv"
+	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/go-openapi/inflect"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 // Mutator changes a Context.
@@ -28,7 +37,8 @@ type Mutator interface {
 	Mutate(ctx *Context) error
 }
 
-// Mutate applies a sequence of mutations to a Context
+// Mutate applies a sequence of mutations to a Context. It stops at the first mutation that fails, and the
+// mutations applied before it are not reverted (see Context.Snapshot).
 func Mutate(ctx *Context, mutations ...Mutator) error {
 	for _, mut := range mutations {
 		if err := mut.Mutate(ctx); err != nil {
@@ -39,13 +49,30 @@ func Mutate(ctx *Context, mutations ...Mutator) error {
 }
 
 // UpsertSchema implements Mutator. UpsertSchema will add to the Context the type named Name if not present and rewrite
-// the type's Fields and Edges methods to return the desired fields and edges.
+// the type's Fields, Edges, Indexes and Annotations methods to return the desired fields, edges, indexes and annotations.
+//
+// Fields that are already declared by the type keep the builder calls of the features that cannot be serialized
+// from their descriptors, such as closures or Match calls used as validators, or annotations without an
+// Annotator. These calls are copied from the existing declaration of the field to the end of its new builder
+// chain. These fields also keep their position, and the fields that are not declared by the type yet are added
+// after them, such that the order of the columns of the type does not depend on the order of Fields.
 type UpsertSchema struct {
 	Name        string
 	Fields      []ent.Field
 	Edges       []ent.Edge
 	Indexes     []ent.Index
 	Annotations []schema.Annotation
+	// Hooks holds the source of the hooks returned by the Hooks method (see AppendHook). The Hooks method
+	// is rewritten only if Hooks is not nil, and is left as is otherwise.
+	Hooks []string
+	// Policy holds the source of the policy returned by the Policy method (see SetPolicy). The Policy method
+	// is rewritten only if Policy is not empty, and is left as is otherwise.
+	Policy string
+	// Merge makes UpsertSchema keep the fields, edges, indexes and annotations that are declared by the type
+	// and are not listed in the UpsertSchema. Fields and edges that are already declared are updated in place
+	// (see UpdateField and UpdateEdge), indexes on the same fields and edges are replaced, and annotations are
+	// only added if the type does not declare an identical one.
+	Merge bool
 }
 
 // Mutate applies the UpsertSchema mutation to the Context.
@@ -55,17 +82,64 @@ func (u *UpsertSchema) Mutate(ctx *Context) error {
 			return err
 		}
 	}
+	if u.Merge {
+		if err := u.merge(ctx); err != nil {
+			return err
+		}
+	} else if err := u.replace(ctx); err != nil {
+		return err
+	}
+	if u.Hooks != nil {
+		if err := resetMethod(ctx, u.Name, kindHook.methodName); err != nil {
+			return err
+		}
+		for _, h := range u.Hooks {
+			if err := ctx.AppendHook(u.Name, h); err != nil {
+				return err
+			}
+		}
+	}
+	if u.Policy != "" {
+		if err := ctx.SetPolicy(u.Name, u.Policy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replace rewrites the Fields, Edges, Indexes and Annotations methods of the type to return the values of u.
+func (u *UpsertSchema) replace(ctx *Context) error {
+	existing, err := ctx.fieldCalls(u.Name)
+	if err != nil {
+		return err
+	}
+	fields := stableFields(ctx.fieldNames(u.Name), u.Fields)
 	if err := resetMethods(ctx, u.Name); err != nil {
 		return err
 	}
-	for _, fld := range u.Fields {
-		if err := ctx.AppendField(u.Name, fld.Descriptor()); err != nil {
+	grafts := make(map[string]string)
+	for _, fld := range fields {
+		desc := fld.Descriptor()
+		// Features that are not declared by the existing field cannot be grafted.
+		stripped, methods := stripUnsupported(desc)
+		call, ok := existing[desc.Name]
+		if !ok || len(methods) == 0 || !declaresAll(call, methods) {
+			if err := ctx.AppendField(u.Name, desc); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := ctx.AppendField(u.Name, stripped); err != nil {
 			return err
 		}
-		if fld.Descriptor().Info.Type == field.TypeUUID {
-			ctx.appendImport(u.Name, "github.com/google/uuid")
+		var err error
+		if grafts[desc.Name], err = ctx.graftedCalls(call, methods); err != nil {
+			return err
 		}
 	}
+	if err := ctx.graftFields(u.Name, grafts); err != nil {
+		return err
+	}
 	for _, edg := range u.Edges {
 		if err := ctx.AppendEdge(u.Name, edg.Descriptor()); err != nil {
 			return err
@@ -84,35 +158,1035 @@ func (u *UpsertSchema) Mutate(ctx *Context) error {
 	return nil
 }
 
-func resetMethods(ctx *Context, typeName string) error {
-	for _, m := range []string{"Fields", "Edges", "Annotations", "Indexes"} {
-		if _, ok := ctx.lookupMethod(typeName, m); !ok {
+// merge adds the fields, edges, indexes and annotations of u to the type, and updates the ones it already
+// declares in place.
+func (u *UpsertSchema) merge(ctx *Context) error {
+	existing, err := ctx.fieldCalls(u.Name)
+	if err != nil {
+		return err
+	}
+	grafts := make(map[string]string)
+	for _, fld := range u.Fields {
+		desc := fld.Descriptor()
+		call, ok := existing[desc.Name]
+		if !ok {
+			if err := ctx.AppendField(u.Name, desc); err != nil {
+				return err
+			}
+			continue
+		}
+		stripped, methods := stripUnsupported(desc)
+		if len(methods) == 0 || !declaresAll(call, methods) {
+			if err := ctx.UpdateField(u.Name, desc); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := ctx.UpdateField(u.Name, stripped); err != nil {
+			return err
+		}
+		if grafts[desc.Name], err = ctx.graftedCalls(call, methods); err != nil {
+			return err
+		}
+	}
+	if err := ctx.graftFields(u.Name, grafts); err != nil {
+		return err
+	}
+	for _, edg := range u.Edges {
+		desc := edg.Descriptor()
+		if _, err := ctx.lookupEdge(u.Name, desc.Name); err == nil {
+			if err := ctx.UpdateEdge(u.Name, desc.Name, desc); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := ctx.AppendEdge(u.Name, desc); err != nil {
+			return err
+		}
+	}
+	for _, annot := range u.Annotations {
+		ok, err := ctx.hasTypeAnnotation(u.Name, annot)
+		if err != nil {
+			return err
+		}
+		if ok {
+			continue
+		}
+		if err := ctx.AppendTypeAnnotation(u.Name, annot); err != nil {
+			return err
+		}
+	}
+	for _, idx := range u.Indexes {
+		if ctx.hasIndex(u.Name, idx) {
+			if err := ctx.RemoveIndex(u.Name, idx); err != nil {
+				return err
+			}
+		}
+		if err := ctx.AppendIndex(u.Name, idx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ThreeWayMerge implements Mutator. ThreeWayMerge applies the changes from Base to Desired, two definitions of
+// the same type (e.g. the outputs of two runs of an importer), to the fields and edges of the type in the
+// Context, while keeping the changes that were made to the type by hand since Base:
+//
+//   - Declarations that did not change between Base and Desired are left as is.
+//   - Declarations that were not edited since Base are updated, added or removed to match Desired.
+//   - Declarations that were changed in both the Context and Desired, differently, are left as is and reported
+//     in Conflicts.
+//
+// Fields and edges are matched by name, and compared by the expressions that declare them. The types of Base and
+// Desired must have the same Name. Indexes, annotations, hooks and policies are not merged.
+type ThreeWayMerge struct {
+	Base    *UpsertSchema
+	Desired *UpsertSchema
+	// Conflicts is set by Mutate to the declarations that could not be merged.
+	Conflicts []MergeConflict
+}
+
+// MergeConflict describes a field or an edge that was changed differently in the Context and in the desired
+// definition of a type. Base, Local and Desired hold the expressions that declare it, or are empty if it is
+// not declared.
+type MergeConflict struct {
+	// Kind is either "field" or "edge".
+	Kind    string
+	Type    string
+	Name    string
+	Base    string
+	Local   string
+	Desired string
+}
+
+// mergeDecls holds the expressions declaring the fields or edges of a type, keyed by name, along with their
+// names in declaration order.
+type mergeDecls struct {
+	names []string
+	exprs map[string]string
+}
+
+func (d *mergeDecls) add(name string, expr ast.Expr) error {
+	s, err := exprString(expr)
+	if err != nil {
+		return err
+	}
+	if d.exprs == nil {
+		d.exprs = make(map[string]string)
+	}
+	d.names = append(d.names, name)
+	d.exprs[name] = s
+	return nil
+}
+
+// Mutate applies the ThreeWayMerge mutation to the Context.
+func (m *ThreeWayMerge) Mutate(ctx *Context) error {
+	if m.Base.Name != m.Desired.Name {
+		return fmt.Errorf("schemast: cannot merge type %q into type %q", m.Base.Name, m.Desired.Name)
+	}
+	typeName := m.Desired.Name
+	if !ctx.HasType(typeName) {
+		if err := ctx.AddType(typeName); err != nil {
+			return err
+		}
+	}
+	m.Conflicts = nil
+	fieldDecls := func(fields []ent.Field) (*mergeDecls, map[string]*field.Descriptor, error) {
+		d, descs := &mergeDecls{}, make(map[string]*field.Descriptor)
+		for _, f := range fields {
+			desc := f.Descriptor()
+			expr, err := Field(desc)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := d.add(desc.Name, expr); err != nil {
+				return nil, nil, err
+			}
+			descs[desc.Name] = desc
+		}
+		return d, descs, nil
+	}
+	base, _, err := fieldDecls(m.Base.Fields)
+	if err != nil {
+		return err
+	}
+	desired, fields, err := fieldDecls(m.Desired.Fields)
+	if err != nil {
+		return err
+	}
+	local := &mergeDecls{}
+	if _, ok := ctx.lookupMethod(typeName, kindField.methodName); ok {
+		calls, err := ctx.returnedCalls(typeName, kindField.methodName)
+		if err != nil {
+			return err
+		}
+		for _, call := range calls {
+			if name, err := extractFieldName(call); err == nil {
+				if err := local.add(name, call); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if err := m.merge("field", typeName, base, local, desired, func(name string, added, removed bool) error {
+		switch {
+		case removed:
+			return ctx.RemoveField(typeName, name)
+		case added:
+			return ctx.AppendField(typeName, fields[name])
+		default:
+			return ctx.UpdateField(typeName, fields[name])
+		}
+	}); err != nil {
+		return err
+	}
+	edgeDecls := func(edges []ent.Edge) (*mergeDecls, map[string]*edge.Descriptor, error) {
+		d, descs := &mergeDecls{}, make(map[string]*edge.Descriptor)
+		for _, e := range edges {
+			desc := e.Descriptor()
+			expr, err := Edge(desc)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := d.add(desc.Name, expr); err != nil {
+				return nil, nil, err
+			}
+			descs[desc.Name] = desc
+		}
+		return d, descs, nil
+	}
+	if base, _, err = edgeDecls(m.Base.Edges); err != nil {
+		return err
+	}
+	desired, edges, err := edgeDecls(m.Desired.Edges)
+	if err != nil {
+		return err
+	}
+	local = &mergeDecls{}
+	if _, ok := ctx.lookupMethod(typeName, kindEdge.methodName); ok {
+		calls, err := ctx.edgeCalls(typeName)
+		if err != nil {
+			return err
+		}
+		for _, call := range calls {
+			if name, err := extractEdgeName(call); err == nil {
+				if err := local.add(name, call); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return m.merge("edge", typeName, base, local, desired, func(name string, added, removed bool) error {
+		switch {
+		case removed:
+			return ctx.RemoveEdge(typeName, name)
+		case added:
+			return ctx.AppendEdge(typeName, edges[name])
+		default:
+			return ctx.UpdateEdge(typeName, name, edges[name])
+		}
+	})
+}
+
+// merge merges the declarations of kind k, calling apply for the declarations of desired that must replace
+// the local ones.
+func (m *ThreeWayMerge) merge(k, typeName string, base, local, desired *mergeDecls, apply func(name string, added, removed bool) error) error {
+	var (
+		names []string
+		seen  = make(map[string]bool)
+	)
+	for _, d := range []*mergeDecls{local, desired, base} {
+		for _, name := range d.names {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	for _, name := range names {
+		b, l, d := base.exprs[name], local.exprs[name], desired.exprs[name]
+		switch {
+		case b == d, l == d:
+			// The declaration did not change upstream, or was changed the same way.
+		case l == b:
+			if err := apply(name, l == "", d == ""); err != nil {
+				return err
+			}
+		default:
+			m.Conflicts = append(m.Conflicts, MergeConflict{Kind: k, Type: typeName, Name: name, Base: b, Local: l, Desired: d})
+		}
+	}
+	return nil
+}
+
+// stableFields returns fields ordered like the names of the existing fields of a type, followed by the fields
+// that the type does not declare yet, in their order in fields.
+func stableFields(names []string, fields []ent.Field) []ent.Field {
+	byName := make(map[string]ent.Field, len(fields))
+	for _, f := range fields {
+		byName[f.Descriptor().Name] = f
+	}
+	ordered := make([]ent.Field, 0, len(fields))
+	for _, name := range names {
+		if f, ok := byName[name]; ok {
+			ordered = append(ordered, f)
+			delete(byName, name)
+		}
+	}
+	for _, f := range fields {
+		if _, ok := byName[f.Descriptor().Name]; ok {
+			ordered = append(ordered, f)
+		}
+	}
+	return ordered
+}
+
+// fieldGrafts holds the features of field descriptors that Field may be unable to serialize, along with the
+// builder methods that declare them.
+var fieldGrafts = []struct {
+	// feature is the name of the feature in unsupported feature errors and warnings.
+	feature     string
+	methods     []string
+	unsupported func(*field.Descriptor) bool
+	strip       func(*field.Descriptor)
+}{
+	{
+		feature: "Descriptor.Validators",
+		methods: []string{"Validate", "NotEmpty", "MinLen", "MaxLen", "Match", "Range", "Min", "Max", "Positive", "Negative", "NonNegative"},
+		unsupported: func(d *field.Descriptor) bool {
+			_, ok := validatorCalls(d)
+			return !ok
+		},
+		strip: func(d *field.Descriptor) { d.Validators = nil },
+	},
+	{
+		feature: "Descriptor.Default",
+		methods: []string{"Default", "DefaultFunc"},
+		unsupported: func(d *field.Descriptor) bool {
+			if d.Default == nil {
+				return false
+			}
+			_, err := defaultExpr(d.Default)
+			return err != nil
+		},
+		strip: func(d *field.Descriptor) { d.Default = nil },
+	},
+	{
+		feature: "Descriptor.UpdateDefault",
+		methods: []string{"UpdateDefault"},
+		unsupported: func(d *field.Descriptor) bool {
+			if d.UpdateDefault == nil {
+				return false
+			}
+			_, err := defaultExpr(d.UpdateDefault)
+			return err != nil
+		},
+		strip: func(d *field.Descriptor) { d.UpdateDefault = nil },
+	},
+	{
+		feature: "Descriptor.Annotations",
+		methods: []string{"Annotations"},
+		unsupported: func(d *field.Descriptor) bool {
+			_, err := toAnnotASTs(d.Annotations)
+			return err != nil
+		},
+		strip: func(d *field.Descriptor) { d.Annotations = nil },
+	},
+}
+
+// stripUnsupported returns a copy of desc without the features listed in fieldGrafts that cannot be
+// serialized, along with the builder methods that declare each of them.
+func stripUnsupported(desc *field.Descriptor) (*field.Descriptor, [][]string) {
+	stripped := *desc
+	var methods [][]string
+	for _, g := range fieldGrafts {
+		if g.unsupported(desc) {
+			g.strip(&stripped)
+			methods = append(methods, g.methods)
+		}
+	}
+	return &stripped, methods
+}
+
+// declaresAll reports whether the builder chain of call holds a call to one of the methods of each group.
+func declaresAll(call *ast.CallExpr, methods [][]string) bool {
+	declared := make(map[string]bool)
+	for _, m := range builderCalls(call)[1:] {
+		declared[methodName(m)] = true
+	}
+	for _, group := range methods {
+		found := false
+		for _, m := range group {
+			found = found || declared[m]
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldCalls returns the declarations of the fields of type typeName, keyed by field name.
+func (c *Context) fieldCalls(typeName string) (map[string]*ast.CallExpr, error) {
+	calls := make(map[string]*ast.CallExpr)
+	if _, ok := c.lookupMethod(typeName, kindField.methodName); !ok {
+		return calls, nil
+	}
+	stmt, err := c.returnStmt(typeName, kindField.methodName)
+	if err != nil {
+		return nil, err
+	}
+	if returned, ok := stmt.Results[0].(*ast.CompositeLit); ok {
+		for _, item := range returned.Elts {
+			call, ok := item.(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			if name, err := extractFieldName(call); err == nil {
+				calls[name] = call
+			}
+		}
+	}
+	return calls, nil
+}
+
+// graftedCalls returns the source of the calls of methods in the builder chain of call, e.g.
+// `.Validate(fn).MaxLen(10)`.
+func (c *Context) graftedCalls(call *ast.CallExpr, methods [][]string) (string, error) {
+	grafted := make(map[string]bool)
+	for _, group := range methods {
+		for _, m := range group {
+			grafted[m] = true
+		}
+	}
+	var b strings.Builder
+	for _, m := range builderCalls(call)[1:] {
+		name := methodName(m)
+		if !grafted[name] {
+			continue
+		}
+		args := make([]string, 0, len(m.Args))
+		for _, arg := range m.Args {
+			var buf bytes.Buffer
+			if err := printer.Fprint(&buf, c.SchemaPackage.Fset, arg); err != nil {
+				return "", err
+			}
+			args = append(args, buf.String())
+		}
+		ellipsis := ""
+		if m.Ellipsis.IsValid() {
+			ellipsis = "..."
+		}
+		fmt.Fprintf(&b, ".%s(%s%s)", name, strings.Join(args, ", "), ellipsis)
+	}
+	return b.String(), nil
+}
+
+// graftFields appends the grafted calls, keyed by field name, to the declarations of the fields of type
+// typeName.
+func (c *Context) graftFields(typeName string, grafts map[string]string) error {
+	if len(grafts) == 0 {
+		return nil
+	}
+	l, err := c.returnedLiteral(kindField, typeName)
+	if err != nil {
+		return err
+	}
+	entries := make([]literalEntry, 0, len(l.entries))
+	for _, e := range l.entries {
+		if call, ok := e.node.(*ast.CallExpr); ok {
+			if name, err := extractFieldName(call); err == nil && grafts[name] != "" {
+				e.text = strings.TrimSuffix(e.text, ",") + grafts[name] + ","
+			}
+		}
+		entries = append(entries, e)
+	}
+	return c.rewrite(l, entries)
+}
+
+// NormalizeMethods implements Mutator. NormalizeMethods ensures that every schema type in the Context
+// declares its Fields and Edges methods with a value receiver and the expected result type, adding
+// methods that return nil where they are missing. It is useful as a preparation step before applying
+// mutations that append fields or edges in bulk.
+type NormalizeMethods struct{}
+
+// Mutate applies the NormalizeMethods mutation to the Context.
+func (NormalizeMethods) Mutate(ctx *Context) error {
+	for _, typeName := range ctx.schemaTypes() {
+		for _, k := range []kind{kindField, kindEdge} {
+			ctx.normalizeReceiver(typeName, k.methodName)
+			fd, ok := ctx.lookupMethod(typeName, k.methodName)
+			if !ok {
+				if err := ctx.appendMethod(typeName, k.methodName, k.ifaceSelector); err != nil {
+					return err
+				}
+				continue
+			}
+			fd.Type.Results = &ast.FieldList{
+				List: []*ast.Field{
+					{Type: &ast.ArrayType{Elt: k.ifaceSelector}},
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// EnsureIDField implements Mutator. EnsureIDField makes every schema type in the Context declare Field, which
+// must be named "id", as its first field. An id field that is declared differently is replaced, an identical
+// one that is not the first field is moved to the start, and types that already declare it first are left as is.
+type EnsureIDField struct {
+	Field ent.Field
+}
+
+// Mutate applies the EnsureIDField mutation to the Context.
+func (e *EnsureIDField) Mutate(ctx *Context) error {
+	desc := e.Field.Descriptor()
+	if desc.Name != "id" {
+		return fmt.Errorf("schemast: EnsureIDField expects a field named \"id\", got %q", desc.Name)
+	}
+	expr, err := Field(desc)
+	if err != nil {
+		return err
+	}
+	expected, err := ctx.exprEntry(expr)
+	if err != nil {
+		return err
+	}
+	for _, typeName := range ctx.schemaTypes() {
+		if call, err := ctx.lookupField(typeName, desc.Name); err == nil {
+			current, err := ctx.exprEntry(call)
+			if err != nil {
+				return err
+			}
+			if current.text == expected.text {
+				if !ctx.isFirstField(typeName, call) {
+					if err := ctx.MoveField(typeName, desc.Name, 0); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := ctx.RemoveField(typeName, desc.Name); err != nil {
+				return err
+			}
+		}
+		if err := ctx.AppendField(typeName, desc, atStart()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnsureInverseEdges implements Mutator. EnsureInverseEdges adds an inverse edge to the target type of every
+// edge declared using edge.To that has none, so that the edge can be traversed in both directions. The added
+// edges are unique, which keeps the relation of the existing edges (O2O or O2M). Edges between a type and
+// itself, edges that go through an edge schema and edges to types that are not declared in the Context are
+// left as is.
+type EnsureInverseEdges struct {
+	// Name returns the name of the inverse edge of the edge edgeName of type typeName. If nil, inverse edges
+	// are named after typeName in snake case, e.g. "user" for the edges of the User type, followed by edgeName
+	// if typeName has several edges to the same type, e.g. "post_author" and "post_reviewer".
+	Name func(typeName, edgeName string) string
+}
+
+// Mutate applies the EnsureInverseEdges mutation to the Context.
+func (e *EnsureInverseEdges) Mutate(ctx *Context) error {
+	var (
+		inverses []EdgeRef
+		// targets counts the edges without inverses of each type to each target type.
+		targets = make(map[[2]string]int)
+	)
+	for _, typeName := range ctx.schemaTypes() {
+		if _, ok := ctx.lookupMethod(typeName, "Edges"); !ok {
 			continue
 		}
-		stmt, err := ctx.returnStmt(typeName, m)
+		calls, err := ctx.edgeCalls(typeName)
+		if err != nil {
+			return err
+		}
+		for _, call := range calls {
+			target, ok := edgeTarget(constructorCall(call))
+			if !ok || target == typeName || methodName(constructorCall(call)) != "To" || !ctx.HasType(target) {
+				continue
+			}
+			if hasBuilderCall(call, "From") || hasBuilderCall(call, "Through") {
+				continue
+			}
+			edgeName, err := extractEdgeName(call)
+			if err != nil {
+				return err
+			}
+			ok, err = ctx.hasInverseEdge(target, typeName, edgeName)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				inverses = append(inverses, EdgeRef{Type: typeName, Edge: edgeName})
+				targets[[2]string{typeName, target}]++
+			}
+		}
+	}
+	for _, ref := range inverses {
+		call, err := ctx.lookupEdge(ref.Type, ref.Edge)
 		if err != nil {
 			return err
 		}
-		stmt.Results = []ast.Expr{ast.NewIdent("nil")}
+		target, _ := edgeTarget(constructorCall(call))
+		inverseName := inflect.Underscore(ref.Type)
+		switch {
+		case e.Name != nil:
+			inverseName = e.Name(ref.Type, ref.Edge)
+		case targets[[2]string{ref.Type, target}] > 1:
+			// The inverses of the edges of a type to the same type are told apart by the names of the edges.
+			inverseName += "_" + ref.Edge
+		}
+		desc := &edge.Descriptor{
+			Name:    inverseName,
+			Type:    ref.Type,
+			RefName: ref.Edge,
+			Inverse: true,
+			Unique:  true,
+		}
+		if _, ok := ctx.lookupMethod(target, "Edges"); ok {
+			if _, err := ctx.lookupEdge(target, desc.Name); err == nil {
+				return fmt.Errorf("schemast: cannot add inverse edge %q of %s.%s, type %q already has an edge with this name", desc.Name, ref.Type, ref.Edge, target)
+			}
+		}
+		if err := ctx.AppendEdge(target, desc); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func (c *Context) appendReturnItem(k kind, typeName string, item ast.Expr) error {
+// hasInverseEdge reports whether the type typeName declares an inverse edge of the edge edgeName of the
+// type refType.
+func (c *Context) hasInverseEdge(typeName, refType, edgeName string) (bool, error) {
+	if _, ok := c.lookupMethod(typeName, "Edges"); !ok {
+		return false, nil
+	}
+	calls, err := c.edgeCalls(typeName)
+	if err != nil {
+		return false, err
+	}
+	for _, call := range calls {
+		if target, ok := edgeTarget(constructorCall(call)); !ok || target != refType {
+			continue
+		}
+		if _, ok := refCall(call, edgeName); ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// hasBuilderCall reports whether the builder chain of call contains a call to the method name, after its
+// constructor.
+func hasBuilderCall(call *ast.CallExpr, name string) bool {
+	for _, b := range builderCalls(call)[1:] {
+		if methodName(b) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RenameTypes implements Mutator. RenameTypes renames every schema type of the Context to the name returned by
+// Name, for example to add a common prefix to the types of a service. The renames cascade like RenameType, and
+// types for which Name returns their current name are left as is.
+type RenameTypes struct {
+	Name func(typeName string) string
+}
+
+// Mutate applies the RenameTypes mutation to the Context.
+func (r *RenameTypes) Mutate(ctx *Context) error {
+	types := ctx.schemaTypes()
+	sort.Strings(types)
+	renamed := make(map[string]string)
+	for _, typeName := range types {
+		newName := r.Name(typeName)
+		if newName == typeName {
+			continue
+		}
+		if !token.IsIdentifier(newName) {
+			return fmt.Errorf("schemast: invalid name %q for type %q", newName, typeName)
+		}
+		renamed[typeName] = newName
+	}
+	targets := make(map[string]string)
+	for _, typeName := range types {
+		newName, ok := renamed[typeName]
+		if !ok {
+			newName = typeName
+		}
+		if other, ok := targets[newName]; ok {
+			return fmt.Errorf("schemast: types %q and %q cannot both be renamed to %q", other, typeName, newName)
+		}
+		targets[newName] = typeName
+		if _, ok := renamed[newName]; !ok && newName != typeName && ctx.HasType(newName) {
+			return fmt.Errorf("schemast: cannot rename type %q, type %q already exists", typeName, newName)
+		}
+	}
+	// Types are first renamed to temporary names, such that renames can be
+	// chained or swapped (e.g. A to B and B to A).
+	temps := make(map[string]string)
+	for _, typeName := range types {
+		if _, ok := renamed[typeName]; !ok {
+			continue
+		}
+		temps[typeName] = fmt.Sprintf("schemastRename%d", len(temps))
+		if err := ctx.RenameType(typeName, temps[typeName]); err != nil {
+			return err
+		}
+	}
+	for _, typeName := range types {
+		if temp, ok := temps[typeName]; ok {
+			if err := ctx.RenameType(temp, renamed[typeName]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SortFields implements Mutator. SortFields sorts the fields of every schema type in the Context by name, which
+// keeps the order of the fields of generated schemas stable. Fields are sorted along with their doc comments,
+// and only among the fields of the same group (see InGroup). Types whose Fields method does not return a
+// literal are left as is. Note that the order of the fields is the order of the columns in the database.
+type SortFields struct {
+	// Less reports whether the field named a sorts before the field named b. If nil, fields are sorted
+	// alphabetically.
+	Less func(a, b string) bool
+}
+
+// Mutate applies the SortFields mutation to the Context.
+func (s *SortFields) Mutate(ctx *Context) error {
+	return sortSchemas(ctx, kindField, extractFieldName, s.Less)
+}
+
+// SortEdges implements Mutator. SortEdges sorts the edges of every schema type in the Context by name, like
+// SortFields does for fields.
+type SortEdges struct {
+	// Less reports whether the edge named a sorts before the edge named b. If nil, edges are sorted
+	// alphabetically.
+	Less func(a, b string) bool
+}
+
+// Mutate applies the SortEdges mutation to the Context.
+func (s *SortEdges) Mutate(ctx *Context) error {
+	return sortSchemas(ctx, kindEdge, extractEdgeName, s.Less)
+}
+
+// sortSchemas sorts the returned values of the method of kind k of every schema type in the Context.
+func sortSchemas(ctx *Context, k kind, name func(*ast.CallExpr) (string, error), less func(a, b string) bool) error {
+	if less == nil {
+		less = func(a, b string) bool {
+			return a < b
+		}
+	}
+	for _, typeName := range ctx.schemaTypes() {
+		if err := ctx.sortItems(k, typeName, name, less); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExtractMixin implements Mutator. ExtractMixin moves the fields named Fields out of the Fields method of each
+// of the Types to a new mixin type named Name, and adds the mixin to the Mixin method of these types. The fields
+// must be declared identically by all the types. If Types is empty, the fields are extracted from all the schema
+// types that declare all of them.
+type ExtractMixin struct {
+	Name   string
+	Fields []string
+	Types  []string
+}
+
+// Mutate applies the ExtractMixin mutation to the Context.
+func (e *ExtractMixin) Mutate(ctx *Context) error {
+	types := e.Types
+	if len(types) == 0 {
+		for _, typeName := range ctx.schemaTypes() {
+			if ctx.hasFields(typeName, e.Fields) {
+				types = append(types, typeName)
+			}
+		}
+		if len(types) == 0 {
+			return fmt.Errorf("schemast: no type declares the fields %q", e.Fields)
+		}
+	}
+	var (
+		entries []literalEntry
+		decls   []string
+		file    *ast.File
+	)
+	for i, typeName := range types {
+		l, err := ctx.returnedLiteral(kindField, typeName)
+		if err != nil {
+			return err
+		}
+		for j, name := range e.Fields {
+			entry, ok := fieldEntry(l, name)
+			if !ok {
+				return fmt.Errorf("schemast: could not find field %q in type %q", name, typeName)
+			}
+			decl, err := exprString(entry.node)
+			if err != nil {
+				return err
+			}
+			if i == 0 {
+				entries, decls = append(entries, entry), append(decls, decl)
+				continue
+			}
+			if decl != decls[j] {
+				return fmt.Errorf("schemast: field %q of type %q is declared differently than in type %q", name, typeName, types[0])
+			}
+		}
+		if i == 0 {
+			file = l.file
+		}
+	}
+	if err := ctx.AddMixinType(e.Name); err != nil {
+		return err
+	}
+	ctx.copyImports(file, ctx.newTypes[e.Name], entries)
+	l, err := ctx.returnedLiteral(kindField, e.Name)
+	if err != nil {
+		return err
+	}
+	if err := ctx.rewrite(l, entries); err != nil {
+		return err
+	}
+	for _, typeName := range types {
+		for _, name := range e.Fields {
+			if err := ctx.RemoveField(typeName, name); err != nil {
+				return err
+			}
+		}
+		if err := ctx.AddMixin(typeName, e.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasFields reports whether type typeName declares all the fields named names.
+func (c *Context) hasFields(typeName string, names []string) bool {
+	for _, name := range names {
+		if _, err := c.lookupField(typeName, name); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldEntry returns the entry of the field named name in a returnedLiteral of the Fields method.
+func fieldEntry(l *returnedLiteral, name string) (literalEntry, bool) {
+	for _, e := range l.entries {
+		call, ok := e.node.(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+		if n, err := extractFieldName(call); err == nil && n == name {
+			return e, true
+		}
+	}
+	return literalEntry{}, false
+}
+
+// copyImports adds to dst the imports of src that are referenced by the nodes of entries.
+func (c *Context) copyImports(src, dst *ast.File, entries []literalEntry) {
+	used := make(map[string]bool)
+	for _, e := range entries {
+		ast.Inspect(e.node, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := sel.X.(*ast.Ident); ok {
+					used[id.Name] = true
+				}
+			}
+			return true
+		})
+	}
+	for _, spec := range src.Imports {
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := c.importName(p)
+		switch {
+		case spec.Name != nil && used[spec.Name.Name]:
+			astutil.AddNamedImport(c.SchemaPackage.Fset, dst, spec.Name.Name, p)
+		case spec.Name == nil && used[name]:
+			astutil.AddImport(c.SchemaPackage.Fset, dst, p)
+		}
+	}
+}
+
+// importName returns the name of the package imported with the path pkgPath by the schema package, which
+// may differ from the last element of its path. Packages that are not imported by the type-checked schema
+// package, e.g. packages imported by mutations, are resolved by their path.
+func (c *Context) importName(pkgPath string) string {
+	if c.SchemaPackage.Types != nil {
+		for _, imp := range c.SchemaPackage.Types.Imports() {
+			if imp.Path() == pkgPath {
+				return imp.Name()
+			}
+		}
+	}
+	return packageName(pkgPath)
+}
+
+// normalizeReceiver rewrites a pointer receiver of the method methodName of type typeName to a value receiver.
+func (c *Context) normalizeReceiver(typeName, methodName string) {
+	for _, file := range c.syntax() {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || len(fd.Recv.List) != 1 || fd.Name.Name != methodName {
+				continue
+			}
+			if star, ok := fd.Recv.List[0].Type.(*ast.StarExpr); ok {
+				if id, ok := star.X.(*ast.Ident); ok && id.Name == typeName {
+					fd.Recv.List[0].Type = id
+				}
+			}
+		}
+	}
+}
+
+func resetMethods(ctx *Context, typeName string) error {
+	for _, m := range []string{"Fields", "Edges", "Annotations", "Indexes"} {
+		if err := resetMethod(ctx, typeName, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resetMethod makes the method m of type typeName return nil, if it is declared. The statements of the method
+// before its return statement are removed.
+func resetMethod(ctx *Context, typeName, m string) error {
+	fd, ok := ctx.lookupMethod(typeName, m)
+	if !ok {
+		return nil
+	}
+	if _, err := ctx.returnStmt(typeName, m); err != nil {
+		return err
+	}
+	stmt := fd.Body.List[len(fd.Body.List)-1].(*ast.ReturnStmt)
+	stmt.Results = []ast.Expr{ast.NewIdent("nil")}
+	fd.Body.List = []ast.Stmt{stmt}
+	return nil
+}
+
+func (c *Context) appendReturnItem(k kind, typeName string, item ast.Expr) (ast.Expr, error) {
 	if _, ok := c.lookupMethod(typeName, k.methodName); !ok {
 		if err := c.appendMethod(typeName, k.methodName, k.ifaceSelector); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	stmt, err := c.returnStmt(typeName, k.methodName)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if lit, ok := stmt.Results[0].(*ast.CompositeLit); ok && c.multiline(lit) {
+		return c.appendLine(k, typeName, item)
 	}
-	return appendToReturn(stmt, k.ifaceSelector, item)
+	if err := appendToReturn(stmt, k.ifaceSelector, item); err != nil {
+		return nil, err
+	}
+	return item, nil
 }
 
-func (c *Context) appendImport(typeName, pkgPath string) {
-	if f, ok := c.newTypes[typeName]; ok {
-		f.Imports = append(f.Imports, &ast.ImportSpec{Path: &ast.BasicLit{Value: pkgPath, Kind: token.STRING}})
+// multiline reports whether the braces of lit, that was parsed from source, are on different lines.
+func (c *Context) multiline(lit *ast.CompositeLit) bool {
+	if !lit.Lbrace.IsValid() || !lit.Rbrace.IsValid() {
+		return false
 	}
+	fset := c.SchemaPackage.Fset
+	return fset.Position(lit.Lbrace).Line != fset.Position(lit.Rbrace).Line
+}
+
+// appendLine adds item on its own line at the end of the multi-line literal returned by the method of kind k
+// of type typeName. Nodes that are appended to the AST of a literal that spans multiple lines are printed on
+// the line of the last element of the literal, and the comments of the literal may be moved around them.
+// Instead, the source of item is inserted before the closing brace of the literal, and the file is parsed
+// again. The returned expression is the added item in the parsed file.
+func (c *Context) appendLine(k kind, typeName string, item ast.Expr) (ast.Expr, error) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, c.SchemaPackage.Fset, item); err != nil {
+		return nil, err
+	}
+	file, src, err := c.reparse(c.methodFile(typeName, k.methodName))
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := c.returnStmt(typeName, k.methodName)
+	if err != nil {
+		return nil, err
+	}
+	lit := stmt.Results[0].(*ast.CompositeLit)
+	end := c.offset(lit.Rbrace)
+	start := bytes.LastIndexByte(src[:end], '\n') + 1
+	text := buf.String() + ",\n"
+	if before := strings.TrimSpace(string(src[start:end])); before != "" {
+		// The closing brace follows the last element, e.g. "	field.String("name")}".
+		start = end
+		if strings.HasSuffix(before, ",") {
+			text = "\n" + text
+		} else {
+			text = ",\n" + text
+		}
+	}
+	updated := append(append(append([]byte{}, src[:start]...), text...), src[start:]...)
+	if _, _, err := c.replaceSource(file, updated); err != nil {
+		return nil, err
+	}
+	return c.elementAt(k, typeName, len(lit.Elts))
+}
+
+// appendImport adds an import of pkgPath to file, if it is not already imported.
+func (c *Context) appendImport(file *ast.File, pkgPath string) {
+	if file != nil {
+		astutil.AddImport(c.SchemaPackage.Fset, file, pkgPath)
+	}
+}
+
+// AddImport adds an import of pkgPath to the file that declares the type typeName, if it is not already
+// imported. It is used to import the packages referenced by the source of hooks, policies or mixins.
+func (c *Context) AddImport(typeName, pkgPath string) error {
+	file, _, ok := c.lookupTypeDecl(typeName)
+	if !ok {
+		return fmt.Errorf("schemast: type %q not found", typeName)
+	}
+	c.appendImport(file, pkgPath)
+	return nil
+}
+
+// appendImports adds imports of the packages in pkgPaths to file, using the paths configured in the
+// ImportPaths of the Context.
+func (c *Context) appendImports(file *ast.File, pkgPaths []string) {
+	for _, pkgPath := range pkgPaths {
+		if p, ok := c.ImportPaths[pkgPath]; ok {
+			pkgPath = p
+		}
+		c.appendImport(file, pkgPath)
+	}
+}
+
+// methodFile returns the file that declares the method methodName of type typeName.
+func (c *Context) methodFile(typeName, methodName string) *ast.File {
+	fd, ok := c.lookupMethod(typeName, methodName)
+	if !ok {
+		return nil
+	}
+	for _, file := range c.syntax() {
+		for _, decl := range file.Decls {
+			if decl == fd {
+				return file
+			}
+		}
+	}
+	return nil
 }
@@ -15,14 +15,25 @@
 package schemast
 
 import (
+	"bytes"
+	"go/printer"
+	"go/token"
+	"regexp"
+	"strings"
 	"testing"
+	"testing/fstest"
 
 	"entgo.io/contrib/entproto"
+	entschema "entgo.io/contrib/schemast/internal/mutatetest/ent/schema"
+	"entgo.io/contrib/schemast/internal/sharedtest/v2"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/go-openapi/inflect"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -79,14 +90,637 @@ func TestUpsert(t *testing.T) {
 	require.Len(t, user.Indexes, 1)
 }
 
+func TestUpsertIndexes(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	upsert := &UpsertSchema{
+		Name: "Message",
+		Fields: []ent.Field{
+			field.String("title"),
+			field.String("slug"),
+			field.Int("version"),
+		},
+		Indexes: []ent.Index{
+			index.Fields("title"),
+			index.Fields("slug", "version").Unique(),
+		},
+	}
+	require.NoError(t, Mutate(tt.ctx, upsert))
+	upsert.Indexes = upsert.Indexes[1:]
+	require.NoError(t, Mutate(tt.ctx, upsert))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	indexes := tt.getType("Message").Indexes
+	require.Len(t, indexes, 1)
+	require.True(t, indexes[0].Unique)
+	require.EqualValues(t, []string{"slug", "version"}, indexes[0].Columns)
+}
+
+func TestUpsertFieldComments(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	fields := []ent.Field{
+		field.String("title").Comment("Title of the message."),
+		field.Int("priority").Optional().Comment("Priority, from 1 (highest) to 5."),
+		field.Enum("status").Values("open", "closed").Comment(`Status of the "thread".`),
+		field.JSON("labels", []string{}).Comment("Labels attached to the message."),
+		field.Bool("pinned"),
+	}
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{Name: "Message", Fields: fields}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	message := tt.getType("Message")
+	require.Len(t, message.Fields, len(fields))
+	for i, f := range message.Fields {
+		require.EqualValues(t, fields[i].Descriptor().Comment, f.Comment(), f.Name)
+	}
+}
+
+func TestUpsertGraftsUnsupported(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	lower := func(s string) error { return nil }
+	err = Mutate(ctx, &UpsertSchema{
+		Name: "WithValidator",
+		Fields: []ent.Field{
+			field.String("name").Optional(),
+			field.String("slug").Validate(lower).Unique(),
+		},
+	})
+	require.NoError(t, err)
+
+	slug, err := ctx.lookupField("WithValidator", "slug")
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, ctx.SchemaPackage.Fset, slug))
+	require.EqualValues(t, `field.String("slug").Unique().Validate(func(s string) error {
+	if strings.ToLower(s) != s {
+		return errors.New("slug must be lowercase")
+	}
+	return nil
+})`, buf.String())
+	name, err := ctx.lookupField("WithValidator", "name")
+	require.NoError(t, err)
+	buf.Reset()
+	require.NoError(t, printer.Fprint(&buf, ctx.SchemaPackage.Fset, name))
+	require.EqualValues(t, `field.String("name").Optional()`, buf.String())
+
+	err = Mutate(ctx, &UpsertSchema{
+		Name:   "WithValidator",
+		Fields: []ent.Field{field.String("name").Validate(lower)},
+	})
+	require.EqualError(t, err, "schemast: unsupported feature Descriptor.Validators")
+}
+
+func TestUpsertKeepsMatch(t *testing.T) {
+	ctx, err := LoadFS(fstest.MapFS{
+		"schema/post.go": {Data: []byte(`package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+type Post struct {
+	ent.Schema
+}
+
+func (Post) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("slug").Match(regexp.MustCompile(` + "`^[a-z-]+$`" + `)),
+	}
+}
+`)},
+	}, "schema/*.go")
+	require.NoError(t, err)
+	// The regular expression of the Match validator is kept from the existing declaration of the field.
+	require.NoError(t, Mutate(ctx, &UpsertSchema{
+		Name: "Post",
+		Fields: []ent.Field{
+			field.String("slug").Match(regexp.MustCompile(`^[a-z-]+$`)).Optional(),
+		},
+	}))
+	files, err := ctx.PrintFiles()
+	require.NoError(t, err)
+	contents := string(files["post.go"])
+	require.Contains(t, contents, "field.String(\"slug\").Optional().Match(regexp.MustCompile(`^[a-z-]+$`)),")
+	require.Contains(t, contents, `"regexp"`)
+}
+
+func TestUpsertStableOrder(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{
+		Name: "User",
+		Fields: []ent.Field{
+			field.String("name"),
+			field.String("email"),
+			field.Int("age"),
+		},
+	}))
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{
+		Name: "User",
+		Fields: []ent.Field{
+			field.Time("created_at"),
+			field.Int("age").Positive(),
+			field.String("bio"),
+			field.String("name"),
+		},
+	}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	var names []string
+	for _, f := range tt.getType("User").Fields {
+		names = append(names, f.Name)
+	}
+	require.EqualValues(t, []string{"name", "age", "created_at", "bio"}, names)
+	require.Contains(t, tt.contents("user.go"), `field.Int("age").Positive()`)
+}
+
+func TestUpsertMerge(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{
+		Name: "User",
+		Fields: []ent.Field{
+			field.String("name"),
+			field.String("email"),
+			field.Int("age"),
+		},
+		Edges: []ent.Edge{
+			WithType(edge.To("messages", placeholder.Type), "Message"),
+		},
+		Indexes: []ent.Index{
+			index.Fields("name"),
+		},
+		Annotations: []schema.Annotation{
+			entsql.Annotation{Table: "users"},
+		},
+	}))
+	require.NoError(t, tt.ctx.DeprecateField("User", "email", "use contacts."))
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{
+		Name:  "User",
+		Merge: true,
+		Fields: []ent.Field{
+			field.String("email").Optional(),
+			field.Time("created_at"),
+		},
+		Edges: []ent.Edge{
+			WithType(edge.To("messages", placeholder.Type).StorageKey(edge.Column("author_id")), "Message"),
+			WithType(edge.To("drafts", placeholder.Type), "Message"),
+		},
+		Indexes: []ent.Index{
+			index.Fields("name").Unique(),
+		},
+		Annotations: []schema.Annotation{
+			entsql.Annotation{Table: "users"},
+		},
+	}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("user.go")
+	require.Contains(t, contents, `return []ent.Field{
+		field.String("name"),
+		// Deprecated: use contacts.
+		field.String("email").Optional(),
+		field.Int("age"),
+		field.Time("created_at"),
+	}`)
+	require.Contains(t, contents, `index.Fields("name").Unique()`)
+	require.Equal(t, 1, strings.Count(contents, `entsql.Annotation{Table: "users"}`))
+	user := tt.getType("User")
+	var names []string
+	for _, f := range user.Fields {
+		names = append(names, f.Name)
+	}
+	require.EqualValues(t, []string{"name", "email", "age", "created_at"}, names)
+	require.True(t, user.Fields[1].Optional)
+	require.Len(t, user.Edges, 2)
+	require.EqualValues(t, "messages", user.Edges[0].Name)
+	require.EqualValues(t, []string{"author_id"}, user.Edges[0].Rel.Columns)
+	require.EqualValues(t, "drafts", user.Edges[1].Name)
+	require.Len(t, user.Indexes, 1)
+	require.True(t, user.Indexes[0].Unique)
+}
+
+func TestThreeWayMerge(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	base := &UpsertSchema{
+		Name: "User",
+		Fields: []ent.Field{
+			field.String("name"),
+			field.Int("age"),
+			field.String("bio"),
+			field.String("role"),
+		},
+		Edges: []ent.Edge{
+			WithType(edge.To("messages", placeholder.Type), "Message"),
+		},
+	}
+	require.NoError(t, Mutate(tt.ctx, base))
+	// Edits made by hand since the type was generated.
+	require.NoError(t, tt.ctx.UpdateField("User", field.String("name").Optional().Descriptor()))
+	require.NoError(t, tt.ctx.RemoveField("User", "bio"))
+	require.NoError(t, tt.ctx.AppendField("User", field.String("nickname").Descriptor()))
+	merge := &ThreeWayMerge{
+		Base: base,
+		Desired: &UpsertSchema{
+			Name: "User",
+			Fields: []ent.Field{
+				field.String("name"),
+				field.Int("age").Positive(),
+				field.String("bio").MaxLen(140),
+				field.String("email"),
+			},
+			Edges: []ent.Edge{
+				WithType(edge.To("messages", placeholder.Type), "Message"),
+				WithType(edge.To("drafts", placeholder.Type), "Message"),
+			},
+		},
+	}
+	require.NoError(t, Mutate(tt.ctx, merge))
+	require.EqualValues(t, []MergeConflict{{
+		Kind:    "field",
+		Type:    "User",
+		Name:    "bio",
+		Base:    `field.String("bio")`,
+		Desired: `field.String("bio").MaxLen(140)`,
+	}}, merge.Conflicts)
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	user := tt.getType("User")
+	var names []string
+	for _, f := range user.Fields {
+		names = append(names, f.Name)
+	}
+	require.EqualValues(t, []string{"name", "age", "nickname", "email"}, names)
+	require.True(t, user.Fields[0].Optional)
+	contents := tt.contents("user.go")
+	require.Contains(t, contents, `field.Int("age").Positive()`)
+	require.Contains(t, contents, `edge.To("drafts", Message.Type)`)
+	require.Len(t, user.Edges, 2)
+
+	err = Mutate(tt.ctx, &ThreeWayMerge{Base: &UpsertSchema{Name: "Message"}, Desired: &UpsertSchema{Name: "User"}})
+	require.EqualError(t, err, `schemast: cannot merge type "Message" into type "User"`)
+}
+
+func TestNormalizeMethods(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	_, ok := ctx.lookupMethod("WithNilFields", "Edges")
+	require.False(t, ok)
+	require.NoError(t, Mutate(ctx, NormalizeMethods{}))
+
+	tests := []struct {
+		typeName string
+		method   string
+		expected string
+	}{
+		{
+			typeName: "WithNilFields",
+			method:   "Edges",
+			expected: `func (WithNilFields) Edges() []ent.Edge {
+	return nil
+}`,
+		},
+		{
+			typeName: "WithoutFields",
+			method:   "Fields",
+			expected: `func (WithoutFields) Fields() []ent.Field {
+	return nil
+}`,
+		},
+		{
+			typeName: "WithPointerReceiver",
+			method:   "Fields",
+			expected: `// Fields of the WithPointerReceiver.
+func (WithPointerReceiver) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+	}
+}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			fd, ok := ctx.lookupMethod(tt.typeName, tt.method)
+			require.True(t, ok)
+			var buf bytes.Buffer
+			require.NoError(t, printer.Fprint(&buf, ctx.SchemaPackage.Fset, fd))
+			require.EqualValues(t, tt.expected, buf.String())
+		})
+	}
+	require.NoError(t, ctx.AppendEdge("WithNilFields", edge.To("owner", entschema.User.Type).Descriptor()))
+}
+
+func TestEnsureIDField(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, ctx.AppendField("WithModifiedField", field.Int("id").Descriptor()))
+	require.NoError(t, ctx.AppendField("WithFields", field.UUID("id", uuid.UUID{}).Default(uuid.New).Descriptor()))
+	conformant, err := ctx.lookupField("WithUUIDID", "id")
+	require.NoError(t, err)
+	require.NoError(t, Mutate(ctx, &EnsureIDField{
+		Field: field.UUID("id", uuid.UUID{}).Default(uuid.New),
+	}))
+
+	tests := []struct {
+		typeName string
+		expected string
+	}{
+		{
+			typeName: "WithFields",
+			expected: `// Fields of the WithFields.
+func (WithFields) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("existing"),
+	}
+}`,
+		},
+		{
+			typeName: "WithModifiedField",
+			expected: `func (WithModifiedField) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").NotEmpty().Immutable().MaxLen(10),
+	}
+}`,
+		},
+		{
+			typeName: "WithNilFields",
+			expected: `// Fields of the WithNilFields.
+func (WithNilFields) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+	}
+}`,
+		},
+		{
+			typeName: "WithUUIDID",
+			expected: `// Fields of the WithUUIDID.
+func (WithUUIDID) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name"),
+	}
+}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			fd, ok := ctx.lookupMethod(tt.typeName, "Fields")
+			require.True(t, ok)
+			var buf bytes.Buffer
+			require.NoError(t, printer.Fprint(&buf, ctx.SchemaPackage.Fset, fd))
+			require.EqualValues(t, tt.expected, buf.String())
+		})
+	}
+	unchanged, err := ctx.lookupField("WithUUIDID", "id")
+	require.NoError(t, err)
+	require.Same(t, conformant, unchanged)
+
+	err = Mutate(ctx, &EnsureIDField{Field: field.UUID("uuid", uuid.UUID{})})
+	require.EqualError(t, err, `schemast: EnsureIDField expects a field named "id", got "uuid"`)
+}
+
 func WithType(e ent.Edge, typeName string) ent.Edge {
 	e.Descriptor().Type = typeName
 	return e
 }
 
+func WithThroughType(e ent.Edge, typeName string) ent.Edge {
+	e.Descriptor().Through.T = typeName
+	return e
+}
+
 type placeholder struct {
 }
 
 func (placeholder) Type() {
 
 }
+
+func TestEnsureInverseEdges(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddType("Pet"))
+	for _, e := range []ent.Edge{
+		WithType(edge.To("messages", placeholder.Type), "Message"),
+		WithType(edge.To("pets", placeholder.Type), "Pet"),
+		WithType(edge.To("friends", placeholder.Type), "User"),
+	} {
+		require.NoError(t, tt.ctx.AppendEdge("User", e.Descriptor()))
+	}
+	owner := WithType(edge.From("owner", placeholder.Type).Ref("pets").Unique(), "User")
+	require.NoError(t, tt.ctx.AppendEdge("Pet", owner.Descriptor()))
+	require.NoError(t, Mutate(tt.ctx, &EnsureInverseEdges{}))
+	require.NoError(t, Mutate(tt.ctx, &EnsureInverseEdges{}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("message.go"), `edge.From("user", User.Type).Ref("messages").Unique()`)
+	for typeName, edges := range map[string][]string{"User": {"messages", "pets", "friends"}, "Pet": {"owner"}, "Message": {"user"}} {
+		var names []string
+		for _, e := range tt.getType(typeName).Edges {
+			names = append(names, e.Name)
+		}
+		require.EqualValues(t, edges, names, typeName)
+	}
+	require.EqualValues(t, "messages", tt.getType("Message").Edges[0].Inverse)
+}
+
+func TestEnsureInverseEdgesSameTarget(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	for _, e := range []ent.Edge{
+		WithType(edge.To("author", placeholder.Type).Unique(), "User"),
+		WithType(edge.To("reviewer", placeholder.Type).Unique(), "User"),
+	} {
+		require.NoError(t, tt.ctx.AppendEdge("Message", e.Descriptor()))
+	}
+	require.NoError(t, Mutate(tt.ctx, &EnsureInverseEdges{}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	var names []string
+	for _, e := range tt.getType("User").Edges {
+		names = append(names, e.Name)
+	}
+	require.EqualValues(t, []string{"message_author", "message_reviewer"}, names)
+
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	for _, name := range []string{"author", "reviewer"} {
+		require.NoError(t, ctx.AppendEdge("WithFields", edge.To(name, entschema.User.Type).Unique().Descriptor()))
+	}
+	err = Mutate(ctx, &EnsureInverseEdges{Name: func(typeName, _ string) string {
+		return inflect.Underscore(typeName)
+	}})
+	require.EqualError(t, err, `schemast: cannot add inverse edge "with_fields" of WithFields.reviewer, type "User" already has an edge with this name`)
+}
+
+func TestEnsureInverseEdgesName(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, Mutate(ctx, &EnsureInverseEdges{
+		Name: func(typeName, edgeName string) string {
+			return "owned_" + inflect.Underscore(inflect.Pluralize(typeName))
+		},
+	}))
+	call, err := ctx.lookupEdge("User", "owned_with_modified_fields")
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), call))
+	require.EqualValues(t, `edge.From("owned_with_modified_fields", WithModifiedField.Type).Ref("owner").Unique()`, buf.String())
+
+	ctx, err = Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, ctx.AppendEdge("User", edge.To("with_modified_field", entschema.WithModifiedField.Type).Descriptor()))
+	err = Mutate(ctx, &EnsureInverseEdges{})
+	require.EqualError(t, err, `schemast: cannot add inverse edge "with_modified_field" of WithModifiedField.owner, type "User" already has an edge with this name`)
+}
+
+func TestRenameTypes(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendEdge("User", WithType(edge.To("messages", placeholder.Type), "Message").Descriptor()))
+	require.NoError(t, tt.ctx.AppendEdge("Message", WithType(edge.From("author", placeholder.Type).Ref("messages").Unique(), "User").Descriptor()))
+	require.NoError(t, Mutate(tt.ctx, &RenameTypes{Name: func(typeName string) string {
+		return "Billing" + typeName
+	}}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Nil(t, tt.getType("User"))
+	require.Nil(t, tt.getType("Message"))
+	require.EqualValues(t, "BillingMessage", tt.getType("BillingUser").Edges[0].Type.Name)
+	require.EqualValues(t, "BillingUser", tt.getType("BillingMessage").Edges[0].Type.Name)
+	require.Contains(t, tt.contents("message.go"), "// BillingMessage holds the schema definition for the BillingMessage entity.")
+}
+
+func TestRenameTypesSwap(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendEdge("User", WithType(edge.To("messages", placeholder.Type), "Message").Descriptor()))
+	swap := map[string]string{"User": "Message", "Message": "User"}
+	require.NoError(t, Mutate(tt.ctx, &RenameTypes{Name: func(typeName string) string {
+		return swap[typeName]
+	}}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	require.EqualValues(t, "User", tt.getType("Message").Edges[0].Type.Name)
+
+	err = Mutate(tt.ctx, &RenameTypes{Name: func(string) string { return "Other" }})
+	require.EqualError(t, err, `schemast: types "Message" and "User" cannot both be renamed to "Other"`)
+	err = Mutate(tt.ctx, &RenameTypes{Name: func(typeName string) string {
+		if typeName == "User" {
+			return "Message"
+		}
+		return typeName
+	}})
+	require.EqualError(t, err, `schemast: types "Message" and "User" cannot both be renamed to "Message"`)
+	err = Mutate(tt.ctx, &RenameTypes{Name: func(typeName string) string { return typeName + "-" }})
+	require.EqualError(t, err, `schemast: invalid name "Message-" for type "Message"`)
+}
+
+func TestSortFieldsEdges(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	for _, name := range []string{"name", "bio"} {
+		require.NoError(t, tt.ctx.AppendField("User", field.String(name).Descriptor()))
+	}
+	for _, name := range []string{"zip", "address", "city"} {
+		require.NoError(t, tt.ctx.AppendField("User", field.String(name).Descriptor(), InGroup("Address")))
+	}
+	require.NoError(t, tt.ctx.DeprecateField("User", "city", "use address."))
+	for _, name := range []string{"author", "replies"} {
+		require.NoError(t, tt.ctx.AppendEdge("Message", WithType(edge.To(name, placeholder.Type), "User").Descriptor()))
+	}
+	require.NoError(t, Mutate(tt.ctx, &SortFields{}, &SortEdges{Less: func(a, b string) bool {
+		return a > b
+	}}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), `return []ent.Field{
+		field.String("bio"),
+		field.String("name"),
+		// --- Address ---
+		field.String("address"),
+		// Deprecated: use address.
+		field.String("city"),
+		field.String("zip"),
+	}`)
+	edges := tt.getType("Message").Edges
+	require.EqualValues(t, "replies", edges[0].Name)
+	require.EqualValues(t, "author", edges[1].Name)
+}
+
+func TestExtractMixin(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	for _, typeName := range []string{"User", "Message"} {
+		require.NoError(t, Mutate(tt.ctx, &UpsertSchema{
+			Name: typeName,
+			Fields: []ent.Field{
+				field.String("name"),
+				field.UUID("tenant_id", uuid.UUID{}),
+				field.Time("deleted_at").Optional(),
+			},
+		}))
+	}
+	err = Mutate(tt.ctx, &ExtractMixin{Name: "TenantMixin", Fields: []string{"tenant_id"}, Types: []string{"User", "Other"}})
+	require.EqualError(t, err, `schemast: type "Other" not found`)
+	err = Mutate(tt.ctx, &ExtractMixin{Name: "TenantMixin", Fields: []string{"tenant"}})
+	require.EqualError(t, err, `schemast: no type declares the fields ["tenant"]`)
+	require.NoError(t, Mutate(tt.ctx, &ExtractMixin{Name: "TenantMixin", Fields: []string{"tenant_id", "deleted_at"}}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("tenant_mixin.go")
+	require.Contains(t, contents, `"github.com/google/uuid"`)
+	require.Contains(t, contents, `field.UUID("tenant_id", uuid.UUID{}),`)
+	require.Contains(t, tt.contents("user.go"), "TenantMixin{}")
+	for _, typeName := range []string{"User", "Message"} {
+		var fields []string
+		for _, f := range tt.getType(typeName).Fields {
+			fields = append(fields, f.Name)
+		}
+		require.EqualValues(t, []string{"tenant_id", "deleted_at", "name"}, fields, typeName)
+	}
+}
+
+func TestExtractMixinPackageName(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	for _, typeName := range []string{"User", "Message"} {
+		require.NoError(t, tt.ctx.AppendField(typeName, field.Time("created_at").Default(sharedtest.Now).Descriptor()))
+	}
+	require.NoError(t, Mutate(tt.ctx, &ExtractMixin{Name: "TimeMixin", Fields: []string{"created_at"}}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("time_mixin.go")
+	require.Contains(t, contents, `"entgo.io/contrib/schemast/internal/sharedtest/v2"`)
+	require.Contains(t, contents, `field.Time("created_at").Default(sharedtest.Now),`)
+	require.EqualValues(t, "created_at", tt.getType("User").Fields[0].Name)
+}
+
+func TestExtractMixinMismatch(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, ctx.AppendField("User", field.String("name").Optional().Descriptor()))
+	require.NoError(t, ctx.AppendField("WithFields", field.String("name").Descriptor()))
+	err = Mutate(ctx, &ExtractMixin{Name: "NameMixin", Fields: []string{"name"}, Types: []string{"WithFields", "User"}})
+	require.EqualError(t, err, `schemast: field "name" of type "User" is declared differently than in type "WithFields"`)
+	require.False(t, ctx.HasType("NameMixin"))
+}
@@ -0,0 +1,78 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+)
+
+// SetPolicy makes the Policy method of type typeName return policy, adding the method if it does not exist.
+// The policy is the source of a Go expression of type ent.Policy, e.g. "privacy.Policy{...}" or a call to a
+// named policy constructor such as "rule.TenantPolicy()". As with AppendHook, the packages referenced by the
+// policy are imported using AddImport.
+func (c *Context) SetPolicy(typeName, policy string) error {
+	if _, err := parser.ParseExpr(policy); err != nil {
+		return fmt.Errorf("schemast: invalid policy expression %q: %w", policy, err)
+	}
+	if _, ok := c.lookupMethod(typeName, "Policy"); !ok {
+		recv := typeName
+		if name := c.receiverName(typeName); name != "" {
+			recv = name + " " + typeName
+		}
+		return c.appendDeclSource(typeName, fmt.Sprintf("func (%s) Policy() ent.Policy {\n\treturn %s\n}", recv, policy))
+	}
+	file, src, err := c.reparse(c.methodFile(typeName, "Policy"))
+	if err != nil {
+		return err
+	}
+	fd, ok := methodDecl(file, typeName, "Policy")
+	if !ok || len(fd.Body.List) != 1 {
+		return fmt.Errorf("schemast: Policy() func body must have a single element")
+	}
+	stmt, ok := fd.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(stmt.Results) != 1 {
+		return fmt.Errorf("schemast: Policy() func body must contain a return statement")
+	}
+	result := stmt.Results[0]
+	src = append(append(append([]byte{}, src[:c.offset(result.Pos())]...), policy...), src[c.offset(result.End()):]...)
+	_, _, err = c.replaceSource(file, src)
+	return err
+}
+
+// RemovePolicy removes the Policy method of type typeName.
+func (c *Context) RemovePolicy(typeName string) error {
+	fd, ok := c.lookupMethod(typeName, "Policy")
+	if !ok {
+		return fmt.Errorf("schemast: could not find method %q for type %q", "Policy", typeName)
+	}
+	file := c.methodFile(typeName, "Policy")
+	for i, decl := range file.Decls {
+		if decl == fd {
+			file.Decls = append(file.Decls[:i], file.Decls[i+1:]...)
+			break
+		}
+	}
+	if fd.Doc != nil {
+		for i, comm := range file.Comments {
+			if comm == fd.Doc {
+				file.Comments = append(file.Comments[:i], file.Comments[i+1:]...)
+				break
+			}
+		}
+	}
+	return nil
+}
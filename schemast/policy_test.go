@@ -0,0 +1,72 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetPolicy(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddImport("User", "entgo.io/ent/privacy"))
+	require.EqualError(t, tt.ctx.AddImport("Other", "entgo.io/ent/privacy"), `schemast: type "Other" not found`)
+	require.NoError(t, tt.ctx.SetPolicy("User", "privacy.Policy{}"))
+	require.NoError(t, tt.ctx.SetPolicy("User", "privacy.Policy{Query: privacy.QueryPolicy{}, Mutation: privacy.MutationPolicy{}}"))
+	require.NoError(t, tt.ctx.SetPolicy("Message", "privacy.Policy{}"))
+	require.NoError(t, tt.ctx.RemovePolicy("Message"))
+	require.EqualError(t, tt.ctx.RemovePolicy("Message"), `schemast: could not find method "Policy" for type "Message"`)
+	require.EqualError(t, tt.ctx.SetPolicy("Other", "privacy.Policy{}"), `schemast: type "Other" not found`)
+	require.Error(t, tt.ctx.SetPolicy("User", "privacy.Policy{"))
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{Name: "Team", Policy: "privacy.Policy{}"}))
+	require.NoError(t, tt.ctx.AddImport("Team", "entgo.io/ent/privacy"))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), "func (User) Policy() ent.Policy {\n\treturn privacy.Policy{Query: privacy.QueryPolicy{}, Mutation: privacy.MutationPolicy{}}\n}")
+	require.EqualValues(t, 1, tt.getType("User").NumPolicy())
+	require.EqualValues(t, 0, tt.getType("Message").NumPolicy())
+	require.EqualValues(t, 1, tt.getType("Team").NumPolicy())
+}
+
+func TestRemovePolicyDoc(t *testing.T) {
+	ctx, err := LoadFS(fstest.MapFS{
+		"schema/user.go": {Data: []byte(`package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+type User struct {
+	ent.Schema
+}
+
+// Policy of the User.
+func (User) Policy() ent.Policy {
+	return privacy.Policy{}
+}
+`)},
+	}, "schema/*.go")
+	require.NoError(t, err)
+	require.NoError(t, ctx.RemovePolicy("User"))
+	files, err := ctx.PrintFiles()
+	require.NoError(t, err)
+	require.NotContains(t, string(files["user.go"]), "Policy of the User")
+	require.NotContains(t, string(files["user.go"]), "func (User) Policy()")
+}
@@ -16,11 +16,19 @@ package schemast
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
 	"go/printer"
+	"go/token"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strings"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"golang.org/x/tools/imports"
 )
 
@@ -30,42 +38,163 @@ type PrintOption func(opt *printOpts)
 // Print writes the updated .go files from Context into path, the directory for the "schema" package in an
 // ent project.  Print receives functional options of type PrintOption that modify its behavior.
 func (c *Context) Print(path string, opts ...PrintOption) error {
+	files, err := c.PrintFiles(opts...)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fn := filepath.Join(path, name)
+		if err := os.MkdirAll(filepath.Dir(fn), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(fn, files[name], 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintFiles returns the formatted sources of the .go files from Context that Print would write, keyed by their
+// paths relative to the schema directory (e.g. "user.go"), without writing them. It receives the same options
+// as Print, and fails if two files of the Context have the same path.
+func (c *Context) PrintFiles(opts ...PrintOption) (map[string][]byte, error) {
 	options := &printOpts{}
 	for _, apply := range opts {
 		apply(options)
 	}
-	for _, file := range c.syntax() {
-		base := filepath.Base(c.SchemaPackage.Fset.File(file.Pos()).Name())
+	names, err := c.fileNames()
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte)
+	for name, file := range names {
 		var buf bytes.Buffer
 		if err := printer.Fprint(&buf, c.SchemaPackage.Fset, file); err != nil {
-			return err
+			return nil, err
 		}
-		fn := filepath.Join(path, base)
-		process, err := imports.Process(base, buf.Bytes(), nil)
+		process, err := imports.Process(name, buf.Bytes(), nil)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if options.headerComment != "" {
 			if s := string(process); s != "" && options.commentRegexp.FindString(s) == "" {
 				process = []byte(options.headerComment + "\n\n" + s)
 			}
 		}
-		if err := os.WriteFile(fn, process, 0600); err != nil {
-			return err
+		files[name] = process
+	}
+	return files, nil
+}
+
+// fileNames returns the files of the Context keyed by their paths relative to the schema directory, the
+// deepest directory that holds all the files of the schema package. The files of the types added to the
+// Context are created in the schema directory, and replace the files with the same path whose declarations
+// were all removed, e.g. by Merge.
+func (c *Context) fileNames() (map[string]*ast.File, error) {
+	var dir string
+	for i, file := range c.SchemaPackage.Syntax {
+		d := filepath.Dir(c.SchemaPackage.Fset.File(file.Pos()).Name())
+		if i == 0 {
+			dir = d
+		}
+		for dir != d && !strings.HasPrefix(d, dir+string(filepath.Separator)) && filepath.Dir(dir) != dir {
+			dir = filepath.Dir(dir)
 		}
 	}
-	return nil
+	names := make(map[string]*ast.File)
+	add := func(name string, file *ast.File) error {
+		switch prev, ok := names[name]; {
+		case !ok || !hasDecls(prev):
+			names[name] = file
+		case hasDecls(file):
+			return fmt.Errorf("schemast: more than one file of the Context would be printed to %s", name)
+		}
+		return nil
+	}
+	for _, file := range c.SchemaPackage.Syntax {
+		name, err := filepath.Rel(dir, c.SchemaPackage.Fset.File(file.Pos()).Name())
+		if err != nil {
+			return nil, err
+		}
+		if err := add(name, file); err != nil {
+			return nil, err
+		}
+	}
+	for _, file := range c.newTypes {
+		if err := add(c.SchemaPackage.Fset.File(file.Pos()).Name(), file); err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+// hasDecls reports whether file has declarations other than its imports.
+func hasDecls(file *ast.File) bool {
+	for _, decl := range file.Decls {
+		if gd, ok := decl.(*ast.GenDecl); !ok || gd.Tok != token.IMPORT {
+			return true
+		}
+	}
+	return false
 }
 
-// Header modifies Print to include a comment at the top of the printed .go files.
+// Diff returns the unified diffs of the changes that Print would make to the .go files in path, keyed by their
+// paths relative to path (e.g. "user.go"). Files that Print would leave unchanged are omitted, and files
+// that do not exist in path yet are compared to an empty file. Diff receives the same options as Print.
+func (c *Context) Diff(path string, opts ...PrintOption) (map[string]string, error) {
+	files, err := c.PrintFiles(opts...)
+	if err != nil {
+		return nil, err
+	}
+	diffs := make(map[string]string)
+	for name, src := range files {
+		fn := filepath.Join(path, name)
+		current, err := os.ReadFile(fn)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if bytes.Equal(current, src) {
+			continue
+		}
+		from := fn
+		if current == nil {
+			from = os.DevNull
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(current)),
+			B:        difflib.SplitLines(string(src)),
+			FromFile: from,
+			ToFile:   fn,
+			Context:  3,
+		})
+		if err != nil {
+			return nil, err
+		}
+		diffs[name] = diff
+	}
+	return diffs, nil
+}
+
+// Header modifies Print to include a comment at the top of the printed .go files. Each line of c
+// becomes a line comment, which allows adding a multi-line banner or a code generation marker.
 // If the file already contains the comment, even if it is not located at the very top of the file
 // the comment will not be appended.
 // Example:
 //  ctx.Print("./schema", schemast.Header("File generated with ent-codegen-plugin.")
 func Header(c string) PrintOption {
+	lines := strings.Split(c, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace("// " + line)
+	}
+	header := strings.Join(lines, "\n")
 	return func(opt *printOpts) {
-		opt.headerComment = "// " + c
-		opt.commentRegexp = regexp.MustCompile("(?m)^" + opt.headerComment + "$")
+		opt.headerComment = header
+		opt.commentRegexp = regexp.MustCompile("(?m)^" + regexp.QuoteMeta(header) + "$")
 	}
 }
 
@@ -15,12 +15,19 @@
 package schemast
 
 import (
+	"math"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema/field"
@@ -89,6 +96,101 @@ func TestPrintHeaderComment(t *testing.T) {
 	require.Len(t, matches, 1)
 }
 
+func TestPrintHeaderTwice(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	header := Header("Code generated by schemast-test, DO NOT EDIT.\nSee the test for details.")
+	require.NoError(t, tt.print(header))
+	ctx, err := Load(tt.schemaDir())
+	require.NoError(t, err)
+	require.NoError(t, ctx.Print(tt.schemaDir(), header))
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("message.go")
+	expected := "// Code generated by schemast-test, DO NOT EDIT.\n// See the test for details.\n\n// Copyright 2019-present Facebook"
+	require.True(t, strings.HasPrefix(contents, expected), "expected header at the top of the file")
+	require.Equal(t, 1, strings.Count(contents, "// Code generated by schemast-test, DO NOT EDIT."))
+	require.Regexp(t, regexp.MustCompile("(?m)^package schema$"), contents)
+}
+
+func TestPrintFiles(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddType("Pet"))
+	files, err := tt.ctx.PrintFiles(Header("File updated by test."))
+	require.NoError(t, err)
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	require.EqualValues(t, []string{"message.go", "pet.go", "user.go"}, names)
+	require.True(t, strings.HasPrefix(string(files["pet.go"]), "// File updated by test.\n\npackage schema"))
+	entries, err := os.ReadDir(tt.schemaDir())
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	require.NoError(t, tt.print(Header("File updated by test.")))
+	for name, src := range files {
+		require.EqualValues(t, string(src), tt.contents(name))
+	}
+}
+
+func TestPrintFilesPaths(t *testing.T) {
+	src := func(typeName string) *fstest.MapFile {
+		return &fstest.MapFile{Data: []byte("package schema\n\nimport \"entgo.io/ent\"\n\ntype " + typeName + " struct {\n\tent.Schema\n}\n")}
+	}
+	ctx, err := LoadFS(fstest.MapFS{
+		"a/schema/user.go": src("User"),
+		"b/schema/user.go": src("Pet"),
+	}, "*/schema/*.go")
+	require.NoError(t, err)
+	files, err := ctx.PrintFiles()
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Contains(t, string(files[filepath.Join("a", "schema", "user.go")]), "type User struct")
+	require.Contains(t, string(files[filepath.Join("b", "schema", "user.go")]), "type Pet struct")
+	dir := t.TempDir()
+	require.NoError(t, ctx.Print(dir))
+	printed, err := os.ReadFile(filepath.Join(dir, "b", "schema", "user.go"))
+	require.NoError(t, err)
+	require.EqualValues(t, files[filepath.Join("b", "schema", "user.go")], printed)
+
+	ctx, err = LoadFS(fstest.MapFS{"schema/user.go": src("Group")}, "schema/*.go")
+	require.NoError(t, err)
+	require.NoError(t, ctx.AddType("User"))
+	_, err = ctx.PrintFiles()
+	require.EqualError(t, err, "schemast: more than one file of the Context would be printed to user.go")
+	_, err = ctx.Diff(t.TempDir())
+	require.EqualError(t, err, "schemast: more than one file of the Context would be printed to user.go")
+}
+
+func TestDiff(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.print())
+	diffs, err := tt.ctx.Diff(tt.schemaDir())
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.String("text").Descriptor()))
+	require.NoError(t, tt.ctx.AddType("Pet"))
+	diffs, err = tt.ctx.Diff(tt.schemaDir())
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	msg := filepath.Join(tt.schemaDir(), "message.go")
+	require.True(t, strings.HasPrefix(diffs["message.go"], "--- "+msg+"\n+++ "+msg+"\n@@ "), diffs["message.go"])
+	require.Contains(t, diffs["message.go"], "+\t\"entgo.io/ent/schema/field\"\n")
+	require.Contains(t, diffs["message.go"], "-\treturn nil\n")
+	require.True(t, strings.HasPrefix(diffs["pet.go"], "--- "+os.DevNull+"\n"))
+	require.Contains(t, diffs["pet.go"], "+type Pet struct {\n")
+
+	require.NoError(t, tt.print())
+	diffs, err = tt.ctx.Diff(tt.schemaDir())
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+}
+
 func TestPrintAddImport(t *testing.T) {
 	tt, err := newPrintTest(t)
 	require.NoError(t, err)
@@ -103,6 +205,177 @@ func TestPrintAddImport(t *testing.T) {
 	require.Equal(t, matches, 1)
 }
 
+func TestPrintTimeDefault(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.Time("created_at").Default(time.Now).Immutable().Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("message.go")
+	require.Contains(t, contents, `"time"`)
+	require.Contains(t, contents, `field.Time("created_at").Default(time.Now).Immutable()`)
+	createdAt := tt.getType("Message").Fields[0]
+	require.True(t, createdAt.Default)
+	require.True(t, createdAt.Immutable)
+}
+
+func TestPrintUpdateDefault(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.Time("created_at").Default(time.Now).Immutable().Descriptor()))
+	require.NoError(t, tt.ctx.AppendField("Message", field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("message.go"), `field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now)`)
+	fields := tt.getType("Message").Fields
+	require.Len(t, fields, 2)
+	require.False(t, fields[0].UpdateDefault)
+	require.True(t, fields[1].Default)
+	require.True(t, fields[1].UpdateDefault)
+}
+
+func TestPrintLiteralDefaults(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	fields := []ent.Field{
+		field.String("title").Default("untitled"),
+		field.Int("priority").Default(-1),
+		field.Float("score").Default(0.5),
+		field.Bool("draft").Default(true),
+		field.Enum("status").Values("open", "closed").Default("open"),
+	}
+	for _, f := range fields {
+		require.NoError(t, tt.ctx.AppendField("Message", f.Descriptor()))
+	}
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	message := tt.getType("Message")
+	require.Len(t, message.Fields, len(fields))
+	for i, f := range message.Fields {
+		require.True(t, f.Default, f.Name)
+		require.EqualValues(t, fields[i].Descriptor().Default, f.DefaultValue(), f.Name)
+	}
+}
+
+func TestPrintSensitive(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.String("password").Sensitive().Descriptor()))
+	require.NoError(t, tt.ctx.AppendField("Message", field.String("hint").Optional().Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	fields := tt.getType("Message").Fields
+	require.Len(t, fields, 2)
+	require.True(t, fields[0].Sensitive())
+	require.False(t, fields[1].Sensitive())
+}
+
+func TestPrintBytes(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.Bytes("payload").Optional().MaxLen(1024).Default([]byte("{}")).Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	payload := tt.getType("Message").Fields[0]
+	require.EqualValues(t, field.TypeBytes, payload.Type.Type)
+	require.True(t, payload.Optional)
+	require.True(t, payload.Default)
+	require.EqualValues(t, 1024, payload.Column().Size)
+}
+
+func TestPrintText(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.Text("body").NotEmpty().Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	require.Contains(t, tt.contents("message.go"), `field.Text("body").NotEmpty()`)
+
+	body := tt.getType("Message").Fields[0]
+	require.EqualValues(t, field.TypeString, body.Type.Type)
+	require.EqualValues(t, math.MaxInt32, body.Column().Size)
+}
+
+func TestPrintJSONHelpers(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.Strings("tags").Optional().Descriptor()))
+	require.NoError(t, tt.ctx.AppendField("Message", field.Ints("counts").Descriptor()))
+	require.NoError(t, tt.ctx.AppendField("Message", field.Floats("scores").Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	fields := tt.getType("Message").Fields
+	require.Len(t, fields, 3)
+	for i, typ := range []string{"[]string", "[]int", "[]float64"} {
+		require.EqualValues(t, field.TypeJSON, fields[i].Type.Type)
+		require.EqualValues(t, typ, fields[i].Type.String())
+	}
+}
+
+func TestPrintEnumValues(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.Enum("status").Values("open", "closed").Default("open").Descriptor()))
+	require.NoError(t, tt.ctx.AppendEnumValue("Message", "status", "archived"))
+	require.NoError(t, tt.ctx.RemoveEnumValue("Message", "status", "closed"))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	status := tt.getType("Message").Fields[0]
+	require.Len(t, status.Enums, 2)
+	require.EqualValues(t, "open", status.Enums[0].Value)
+	require.EqualValues(t, "archived", status.Enums[1].Value)
+	require.EqualValues(t, "open", status.DefaultValue())
+}
+
+func TestPrintEntSQLAnnotations(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	desc := field.Int("seq").Annotations(entsql.Annotation{Incremental: new(bool), Default: "0"}).Descriptor()
+	require.NoError(t, tt.ctx.AppendField("Message", desc))
+	require.NoError(t, tt.ctx.AppendTypeAnnotation("Message", entsql.Annotation{Table: "messages", Charset: "utf8mb4"}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	message := tt.getType("Message")
+	require.EqualValues(t, "messages", message.Table())
+	require.EqualValues(t, "utf8mb4", message.Annotations["EntSQL"].(map[string]interface{})["charset"])
+	annot := message.Fields[0].Annotations["EntSQL"].(map[string]interface{})
+	require.EqualValues(t, false, annot["incremental"])
+	require.EqualValues(t, "0", annot["default"])
+}
+
+func TestPrintEntGQLAnnotations(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	desc := field.Time("created_at").Annotations(entgql.OrderField("CREATED_AT")).Descriptor()
+	require.NoError(t, tt.ctx.AppendField("Message", desc))
+	require.NoError(t, tt.ctx.AppendTypeAnnotation("Message", entgql.RelayConnection()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	require.Contains(t, tt.contents("message.go"), `"entgo.io/contrib/entgql"`)
+
+	message := tt.getType("Message")
+	require.EqualValues(t, true, message.Annotations["EntGQL"].(map[string]interface{})["RelayConnection"])
+	require.EqualValues(t, "CREATED_AT", message.Fields[0].Annotations["EntGQL"].(map[string]interface{})["OrderField"])
+}
+
 func TestPrintStructOnSameLine(t *testing.T) {
 	tt, err := newPrintTest(t)
 	require.NoError(t, err)
@@ -15,16 +15,31 @@
 package schemast
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
 	"go/parser"
+	"go/printer"
 	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/go-openapi/inflect"
 )
 
 // RemoveType removes the type definition as well as any method receivers or associated comment groups from the context.
+// Methods with value and pointer receivers are removed. If edges of other types reference the type, the Context is
+// left unchanged and an error listing them is returned, see DropType for removing them along with the type.
 func (c *Context) RemoveType(typeName string) error {
+	_, err := c.DropType(typeName, false)
+	return err
+}
+
+// removeType removes the type typeName like RemoveType, leaving the edges that reference it untouched.
+func (c *Context) removeType(typeName string) error {
 	_, found := c.newTypes[typeName]
 	if found {
 		delete(c.newTypes, typeName)
@@ -35,18 +50,30 @@ func (c *Context) RemoveType(typeName string) error {
 		ast.Inspect(file, func(node ast.Node) bool {
 			switch n := node.(type) {
 			case *ast.FuncDecl:
-				if len(n.Recv.List) > 0 {
-					if id, ok := n.Recv.List[0].Type.(*ast.Ident); ok && id.Name == typeName {
-						toRemDecl[n] = struct{}{}
-						toRemComments[n.Doc] = struct{}{}
-						found = true
-					}
+				if n.Recv != nil && len(n.Recv.List) > 0 && recvTypeName(n.Recv.List[0].Type) == typeName {
+					toRemDecl[n] = struct{}{}
+					toRemComments[n.Doc] = struct{}{}
+					found = true
 				}
 			case *ast.GenDecl:
-				if isTypeDeclFor(n, typeName) {
+				ts, ok := typeSpecFor(n, typeName)
+				if !ok {
+					break
+				}
+				if len(n.Specs) == 1 {
 					toRemDecl[n] = struct{}{}
 					toRemComments[n.Doc] = struct{}{}
+					break
 				}
+				// Only the spec is removed from groups that declare other types.
+				for i, spec := range n.Specs {
+					if spec == ts {
+						n.Specs = append(n.Specs[:i], n.Specs[i+1:]...)
+						break
+					}
+				}
+				toRemComments[ts.Doc] = struct{}{}
+				toRemComments[ts.Comment] = struct{}{}
 			}
 			return true
 		})
@@ -71,25 +98,177 @@ func (c *Context) RemoveType(typeName string) error {
 	return nil
 }
 
+// RenameType renames the schema type oldName to newName. The type declaration, the receivers of its methods
+// and the Old.Type references of the edges and edge schemas of all types are renamed, and whole-word
+// occurrences of oldName in the doc comments of the type and its methods are replaced. The file that declares
+// the type keeps its name.
+func (c *Context) RenameType(oldName, newName string) error {
+	_, decl, ok := c.lookupTypeDecl(oldName)
+	if !ok {
+		return fmt.Errorf("schemast: type %q not found", oldName)
+	}
+	if c.HasType(newName) {
+		return fmt.Errorf("schemast: type %q already exists", newName)
+	}
+	word := regexp.MustCompile(`\b` + regexp.QuoteMeta(oldName) + `\b`)
+	renameDoc := func(doc *ast.CommentGroup) {
+		if doc == nil {
+			return
+		}
+		for _, cm := range doc.List {
+			cm.Text = word.ReplaceAllString(cm.Text, newName)
+		}
+	}
+	ts, _ := typeSpecFor(decl, oldName)
+	ts.Name.Name = newName
+	renameDoc(decl.Doc)
+	renameDoc(ts.Doc)
+	for _, file := range c.syntax() {
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.FuncDecl:
+				if n.Recv == nil || len(n.Recv.List) != 1 {
+					return true
+				}
+				recv := n.Recv.List[0]
+				if star, ok := recv.Type.(*ast.StarExpr); ok {
+					if id, ok := star.X.(*ast.Ident); ok && id.Name == oldName {
+						id.Name = newName
+						renameDoc(n.Doc)
+					}
+				} else if id, ok := recv.Type.(*ast.Ident); ok && id.Name == oldName {
+					id.Name = newName
+					renameDoc(n.Doc)
+				}
+			case *ast.SelectorExpr:
+				if id, ok := n.X.(*ast.Ident); ok && id.Name == oldName && n.Sel.Name == "Type" {
+					id.Name = newName
+				}
+			}
+			return true
+		})
+	}
+	if f, ok := c.newTypes[oldName]; ok {
+		delete(c.newTypes, oldName)
+		c.newTypes[newName] = f
+	}
+	return nil
+}
+
+// EdgeRef identifies the edge named Edge of the schema type named Type.
+type EdgeRef struct {
+	Type string
+	Edge string
+}
+
+// DropType removes the type named typeName from the Context like RemoveType, and returns the edges of the
+// remaining types that reference it. If cascade is true, these edges are removed as well. Otherwise, the
+// Context is left unchanged and an error listing the dangling edges is returned.
+func (c *Context) DropType(typeName string, cascade bool) ([]EdgeRef, error) {
+	refs, err := c.edgeRefs(typeName)
+	if err != nil {
+		return nil, err
+	}
+	if len(refs) > 0 && !cascade {
+		names := make([]string, 0, len(refs))
+		for _, ref := range refs {
+			names = append(names, ref.Type+"."+ref.Edge)
+		}
+		return refs, fmt.Errorf("schemast: type %q is referenced by edges: %s", typeName, strings.Join(names, ", "))
+	}
+	if err := c.removeType(typeName); err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		if err := c.RemoveEdge(ref.Type, ref.Edge); err != nil {
+			return nil, err
+		}
+	}
+	return refs, nil
+}
+
+// edgeRefs returns the edges of types other than typeName that reference the type typeName.
+func (c *Context) edgeRefs(typeName string) ([]EdgeRef, error) {
+	var refs []EdgeRef
+	for _, name := range c.schemaTypes() {
+		if name == typeName {
+			continue
+		}
+		if _, ok := c.lookupMethod(name, "Edges"); !ok {
+			continue
+		}
+		stmt, err := c.returnStmt(name, "Edges")
+		if err != nil {
+			return nil, err
+		}
+		returned, ok := stmt.Results[0].(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+		for _, item := range returned.Elts {
+			call, ok := item.(*ast.CallExpr)
+			if !ok || !referencesType(call, typeName) {
+				continue
+			}
+			edgeName, err := extractEdgeName(call)
+			if err != nil {
+				return nil, err
+			}
+			refs = append(refs, EdgeRef{Type: name, Edge: edgeName})
+		}
+	}
+	return refs, nil
+}
+
+// referencesType reports whether node contains a typeName.Type expression.
+func referencesType(node ast.Node, typeName string) bool {
+	var found bool
+	ast.Inspect(node, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok && sel.Sel.Name == "Type" {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Name == typeName {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// AddType adds a new schema type named typeName to the Context. The generated methods use the receiver
+// configured by the ReceiverStyle of the Context.
 func (c *Context) AddType(typeName string) error {
-	body := fmt.Sprintf(`package schema
+	recv := typeName
+	if name := c.receiverName(typeName); name != "" {
+		recv = name + " " + typeName
+	}
+	pkgName := c.SchemaPackage.Name
+	if pkgName == "" {
+		// The package has no files yet.
+		pkgName = "schema"
+	}
+	if c.SchemaPackage.Fset == nil {
+		c.SchemaPackage.Fset = token.NewFileSet()
+	}
+	body := fmt.Sprintf(`package %[3]s
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 )
-type %s struct {
+type %[1]s struct {
 	ent.Schema
 }
-func (%s) Fields() []ent.Field {
+func (%[2]s) Fields() []ent.Field {
 	return nil
 }
-func (%s) Edges() []ent.Edge {
+func (%[2]s) Edges() []ent.Edge {
 	return nil
 }
-func (%s) Annotations() []schema.Annotation {
+func (%[2]s) Annotations() []schema.Annotation {
 	return nil
 }
-`, typeName, typeName, typeName, typeName)
+`, typeName, recv, pkgName)
 	fn := inflect.Underscore(typeName) + ".go"
 	f, err := parser.ParseFile(c.SchemaPackage.Fset, fn, body, 0)
 	if err != nil {
@@ -99,11 +278,365 @@ func (%s) Annotations() []schema.Annotation {
 	return nil
 }
 
-func isTypeDeclFor(n *ast.GenDecl, typeName string) bool {
-	if n.Tok == token.TYPE && len(n.Specs) > 0 {
-		if ts, ok := n.Specs[0].(*ast.TypeSpec); ok {
-			return ts.Name.Name == typeName
+// CopyType copies the schema type typeName from the Context src to c. The type declaration and its methods,
+// such as Fields, Edges and Mixin, are copied along with their doc comments and the imports they use, to a new
+// file named after the type. Other declarations that the type depends on, such as mixins declared in the
+// schema package of src, are not copied.
+func (c *Context) CopyType(src *Context, typeName string) error {
+	if c.HasType(typeName) {
+		return fmt.Errorf("schemast: type %q already exists", typeName)
+	}
+	if !src.HasType(typeName) {
+		return fmt.Errorf("schemast: type %q not found", typeName)
+	}
+	var (
+		decls   []string
+		imports []string
+		seen    = make(map[string]bool)
+	)
+	for _, file := range src.syntax() {
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, src.SchemaPackage.Fset, file); err != nil {
+			return err
+		}
+		fset := token.NewFileSet()
+		parsed, err := parser.ParseFile(fset, "", buf.Bytes(), parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		used := make(map[string]bool)
+		for _, decl := range parsed.Decls {
+			var doc *ast.CommentGroup
+			text := func(from, to token.Pos) string {
+				return string(buf.Bytes()[fset.Position(from).Offset:fset.Position(to).Offset])
+			}
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				ts, ok := typeSpecFor(d, typeName)
+				if !ok {
+					continue
+				}
+				doc = d.Doc
+				if len(d.Specs) > 1 {
+					// Only the spec is copied from groups that declare other types.
+					var spec string
+					if ts.Doc != nil {
+						spec = text(ts.Doc.Pos(), ts.Doc.End()) + "\n"
+					}
+					decls = append(decls, spec+"type "+text(ts.Pos(), ts.End()))
+					markUsed(ts, used)
+					continue
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil || len(d.Recv.List) != 1 || recvTypeName(d.Recv.List[0].Type) != typeName {
+					continue
+				}
+				doc = d.Doc
+			}
+			start := decl.Pos()
+			if doc != nil {
+				start = doc.Pos()
+			}
+			decls = append(decls, text(start, decl.End()))
+			markUsed(decl, used)
+		}
+		for _, spec := range parsed.Imports {
+			p, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				continue
+			}
+			name := src.importName(p)
+			line := strconv.Quote(p)
+			if spec.Name != nil {
+				name = spec.Name.Name
+				line = name + " " + line
+			}
+			if used[name] && !seen[line] {
+				seen[line] = true
+				imports = append(imports, line)
+			}
+		}
+	}
+	pkgName := c.SchemaPackage.Name
+	if pkgName == "" {
+		pkgName = "schema"
+	}
+	if c.SchemaPackage.Fset == nil {
+		c.SchemaPackage.Fset = token.NewFileSet()
+	}
+	body := fmt.Sprintf("package %s\n\nimport (\n%s\n)\n\n%s\n", pkgName, strings.Join(imports, "\n"), strings.Join(decls, "\n\n"))
+	f, err := parser.ParseFile(c.SchemaPackage.Fset, inflect.Underscore(typeName)+".go", body, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	c.newTypes[typeName] = f
+	return nil
+}
+
+// markUsed marks the package qualifiers of the selector expressions of node as used.
+func markUsed(node ast.Node, used map[string]bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+}
+
+// AddMethod adds the methods declared by src to the type typeName. The source, which may include doc
+// comments, is copied verbatim to the end of the file that declares the type. Every declaration of src must
+// be a method of typeName, either with a value or a pointer receiver, that the type does not declare yet.
+func (c *Context) AddMethod(typeName, src string) error {
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+src, 0)
+	if err != nil {
+		return fmt.Errorf("schemast: invalid method source: %w", err)
+	}
+	if len(f.Decls) == 0 {
+		return fmt.Errorf("schemast: expected method declarations in source")
+	}
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || len(fd.Recv.List) != 1 || recvTypeName(fd.Recv.List[0].Type) != typeName {
+			return fmt.Errorf("schemast: expected source to only declare methods of type %q", typeName)
+		}
+		if c.hasMethod(typeName, fd.Name.Name) {
+			return fmt.Errorf("schemast: type %q already has a method %q", typeName, fd.Name.Name)
+		}
+	}
+	return c.appendDeclSource(typeName, src)
+}
+
+// hasMethod reports whether type typeName declares a method named name, with a value or a pointer receiver.
+func (c *Context) hasMethod(typeName, name string) bool {
+	for _, file := range c.syntax() {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if ok && fd.Recv != nil && len(fd.Recv.List) == 1 && fd.Name.Name == name && recvTypeName(fd.Recv.List[0].Type) == typeName {
+				return true
+			}
 		}
 	}
 	return false
 }
+
+// recvTypeName returns the name of the type of a method receiver, e.g. "User" for both User and *User.
+func recvTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if id, ok := expr.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// SetTypeComment sets the doc comment of the declaration of type typeName to comment, replacing its existing
+// doc comment. Each line of comment becomes a line comment, and an empty comment removes the doc comment.
+func (c *Context) SetTypeComment(typeName, comment string) error {
+	file, _, ok := c.lookupTypeDecl(typeName)
+	if !ok {
+		return fmt.Errorf("schemast: type %q not found", typeName)
+	}
+	file, src, err := c.reparse(file)
+	if err != nil {
+		return err
+	}
+	var (
+		doc *ast.CommentGroup
+		pos token.Pos
+	)
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || !isTypeDeclFor(gd, typeName) {
+			continue
+		}
+		doc, pos = gd.Doc, gd.Pos()
+		if gd.Lparen.IsValid() {
+			// The type is declared in a group, e.g. type ( User struct{...} ).
+			ts, _ := typeSpecFor(gd, typeName)
+			doc, pos = ts.Doc, ts.Pos()
+		}
+	}
+	start, end := c.offset(pos), c.offset(pos)
+	if doc != nil {
+		start = c.offset(doc.Pos())
+	}
+	var text string
+	if comment != "" {
+		var lines []string
+		for _, e := range commentEntries(comment) {
+			lines = append(lines, e.text)
+		}
+		text = strings.Join(lines, "\n") + "\n"
+	}
+	_, _, err = c.replaceSource(file, append(append(append([]byte{}, src[:start]...), text...), src[end:]...))
+	return err
+}
+
+// ConflictResolution defines how Merge resolves a type that is declared by both of the merged Contexts.
+type ConflictResolution int
+
+const (
+	// KeepDst keeps the type of the destination Context.
+	KeepDst ConflictResolution = iota
+	// KeepSrc replaces the type of the destination Context with the type of the source Context.
+	KeepSrc
+)
+
+// MergeOption configures Merge.
+type MergeOption func(*mergeOpts)
+
+type mergeOpts struct {
+	resolve func(typeName string) (ConflictResolution, error)
+}
+
+// OnConflict sets the function that resolves the types declared by both Contexts of Merge. An error returned
+// by resolve fails Merge. By default, Merge fails if a type is declared by both Contexts.
+func OnConflict(resolve func(typeName string) (ConflictResolution, error)) MergeOption {
+	return func(opts *mergeOpts) {
+		opts.resolve = resolve
+	}
+}
+
+// Merge copies the types declared in src, such as schemas and mixins, to dst using CopyType, for example to
+// combine generated types with hand-written ones. The conflicts are resolved before dst is changed, such that
+// dst is left unchanged if one of them fails.
+func Merge(dst, src *Context, opts ...MergeOption) error {
+	options := &mergeOpts{
+		resolve: func(typeName string) (ConflictResolution, error) {
+			return 0, fmt.Errorf("schemast: type %q is declared by both contexts", typeName)
+		},
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	names, _ := src.typeSpecs()
+	var (
+		copied   []string
+		replaced = make(map[string]bool)
+	)
+	for _, name := range names {
+		if !dst.HasType(name) {
+			copied = append(copied, name)
+			continue
+		}
+		res, err := options.resolve(name)
+		if err != nil {
+			return err
+		}
+		switch res {
+		case KeepDst:
+		case KeepSrc:
+			copied = append(copied, name)
+			replaced[name] = true
+		default:
+			return fmt.Errorf("schemast: unknown conflict resolution %d for type %q", res, name)
+		}
+	}
+	for _, name := range copied {
+		if replaced[name] {
+			if err := dst.removeType(name); err != nil {
+				return err
+			}
+		}
+		if err := dst.CopyType(src, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// schemaTypes returns the names of the types in the Context that embed ent.Schema, either directly or
+// through another embedded struct type (e.g. a BaseSchema type that embeds ent.Schema).
+func (c *Context) schemaTypes() []string {
+	names, specs := c.typeSpecs()
+	var schemas []string
+	for _, name := range names {
+		if isSchemaStruct(specs, specs[name], make(map[string]bool)) {
+			schemas = append(schemas, name)
+		}
+	}
+	return schemas
+}
+
+// typeSpecs returns the names of the types declared in the Context, in the order of their declarations, and
+// their specs.
+func (c *Context) typeSpecs() ([]string, map[string]*ast.TypeSpec) {
+	specs := make(map[string]*ast.TypeSpec)
+	var names []string
+	for _, file := range c.syntax() {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					specs[ts.Name.Name] = ts
+					names = append(names, ts.Name.Name)
+				}
+			}
+		}
+	}
+	return names, specs
+}
+
+// isSchemaStruct reports whether ts declares a struct that embeds ent.Schema, directly or through one of
+// the struct types in specs.
+func isSchemaStruct(specs map[string]*ast.TypeSpec, ts *ast.TypeSpec, seen map[string]bool) bool {
+	if seen[ts.Name.Name] {
+		return false
+	}
+	seen[ts.Name.Name] = true
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || st.Fields == nil {
+		return false
+	}
+	for _, fld := range st.Fields.List {
+		if len(fld.Names) != 0 {
+			continue
+		}
+		switch t := fld.Type.(type) {
+		case *ast.SelectorExpr:
+			if x, ok := t.X.(*ast.Ident); ok && x.Name == "ent" && t.Sel.Name == "Schema" {
+				return true
+			}
+		case *ast.Ident:
+			if embedded, ok := specs[t.Name]; ok && isSchemaStruct(specs, embedded, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// receiverName returns the name of the receiver for methods generated for typeName, or an empty
+// string if the receiver should be unnamed.
+func (c *Context) receiverName(typeName string) string {
+	if c.ReceiverStyle == ReceiverShortName {
+		r, _ := utf8.DecodeRuneInString(typeName)
+		return string(unicode.ToLower(r))
+	}
+	return ""
+}
+
+func isTypeDeclFor(n *ast.GenDecl, typeName string) bool {
+	_, ok := typeSpecFor(n, typeName)
+	return ok
+}
+
+// typeSpecFor returns the spec of the type typeName declared by n, which may be one of several types
+// declared in a group, e.g. type ( A struct{...}; B struct{...} ).
+func typeSpecFor(n *ast.GenDecl, typeName string) (*ast.TypeSpec, bool) {
+	if n.Tok != token.TYPE {
+		return nil, false
+	}
+	for _, spec := range n.Specs {
+		if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == typeName {
+			return ts, true
+		}
+	}
+	return nil, false
+}
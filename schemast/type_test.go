@@ -20,8 +20,15 @@ import (
 	"os"
 	"path"
 	"testing"
+	"testing/fstest"
 
+	"entgo.io/contrib/schemast/internal/sharedtest/v2"
+	"entgo.io/ent"
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,6 +49,100 @@ func TestContext_AddType(t *testing.T) {
 }`, buf.String())
 }
 
+func TestContext_BaseSchema(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.True(t, ctx.HasType("WithBaseSchema"))
+	require.Contains(t, ctx.schemaTypes(), "WithBaseSchema")
+	require.Contains(t, ctx.schemaTypes(), "WithFields")
+	require.NoError(t, ctx.AppendField("WithBaseSchema", field.String("nickname").Descriptor()))
+	require.True(t, ctx.HasField("WithBaseSchema", "nickname"))
+}
+
+func TestContext_AddTypeReceiverStyle(t *testing.T) {
+	tests := []struct {
+		style    ReceiverStyle
+		expected string
+	}{
+		{
+			style: ReceiverTypeName,
+			expected: `func (Cat) Indexes() []ent.Index {
+	return []ent.Index{index.Fields("name")}
+}`,
+		},
+		{
+			style: ReceiverShortName,
+			expected: `func (c Cat) Indexes() []ent.Index {
+	return []ent.Index{index.Fields("name")}
+}`,
+		},
+	}
+	for _, tt := range tests {
+		ctx, err := Load("./internal/mutatetest/ent/schema")
+		require.NoError(t, err)
+		ctx.ReceiverStyle = tt.style
+		require.NoError(t, ctx.AddType("Cat"))
+		require.NoError(t, ctx.AppendIndex("Cat", index.Fields("name")))
+		fields, ok := ctx.lookupMethod("Cat", "Fields")
+		require.True(t, ok)
+		require.Equal(t, tt.style == ReceiverShortName, len(fields.Recv.List[0].Names) == 1)
+
+		var buf bytes.Buffer
+		method, _ := ctx.lookupMethod("Cat", "Indexes")
+		err = printer.Fprint(&buf, ctx.SchemaPackage.Fset, method)
+		require.NoError(t, err)
+		require.EqualValues(t, tt.expected, buf.String())
+	}
+}
+
+func TestContext_ReceiverName(t *testing.T) {
+	ctx := &Context{ReceiverStyle: ReceiverShortName}
+	require.Equal(t, "c", ctx.receiverName("Cat"))
+	require.Equal(t, "é", ctx.receiverName("Élan"))
+	ctx.ReceiverStyle = ReceiverTypeName
+	require.Empty(t, ctx.receiverName("Élan"))
+}
+
+func TestContext_AddTypeEmptyPackage(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "placeholder",
+			files: map[string]string{
+				"doc.go": "// Package schema holds the ent schema.\npackage schema\n",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := os.MkdirTemp(".", "emptytest-")
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				os.RemoveAll(dir)
+			})
+			for name, contents := range tt.files {
+				require.NoError(t, os.WriteFile(path.Join(dir, name), []byte(contents), 0600))
+			}
+			ctx, err := Load("./" + dir)
+			require.NoError(t, err)
+			require.NoError(t, ctx.AddType("User"))
+			require.NoError(t, ctx.AppendField("User", field.String("name").Descriptor()))
+			require.NoError(t, ctx.Print(dir))
+
+			graph, err := entc.LoadGraph("./"+dir, &gen.Config{})
+			require.NoError(t, err)
+			require.Len(t, graph.Nodes, 1)
+			require.EqualValues(t, "User", graph.Nodes[0].Name)
+			require.Len(t, graph.Nodes[0].Fields, 1)
+		})
+	}
+}
+
 func TestContext_RemoveType(t *testing.T) {
 	tt, err := newPrintTest(t)
 	require.NoError(t, err)
@@ -64,3 +165,230 @@ func TestContext_RemoveType(t *testing.T) {
 	require.NoError(t, err)
 	require.NotContains(t, string(file), "// Message holds the schema definition for the Message entity.")
 }
+
+func TestContext_RemoveTypeReferenced(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendEdge("User", WithType(edge.To("messages", placeholder.Type), "Message").Descriptor()))
+	err = tt.ctx.RemoveType("Message")
+	require.EqualError(t, err, `schemast: type "Message" is referenced by edges: User.messages`)
+	require.True(t, tt.ctx.HasType("Message"))
+	_, err = tt.ctx.lookupEdge("User", "messages")
+	require.NoError(t, err)
+}
+
+func TestContext_RemoveTypeMethods(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddMethod("Message", "func (m *Message) isMessage() bool { return true }"))
+	require.NoError(t, tt.ctx.appendDeclSource("Message", "func newMessage() int { return 1 }"))
+	require.NoError(t, tt.ctx.RemoveType("Message"))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	require.Nil(t, tt.getType("Message"))
+
+	contents := tt.contents("message.go")
+	require.NotContains(t, contents, "isMessage")
+	require.Contains(t, contents, "func newMessage() int { return 1 }")
+}
+
+func TestContext_DropType(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendEdge("Message", WithType(edge.To("author", placeholder.Type), "User").Descriptor()))
+	require.NoError(t, tt.ctx.AppendEdge("User", WithType(edge.To("friends", placeholder.Type), "User").Descriptor()))
+
+	refs, err := tt.ctx.DropType("User", false)
+	require.EqualError(t, err, `schemast: type "User" is referenced by edges: Message.author`)
+	require.EqualValues(t, []EdgeRef{{Type: "Message", Edge: "author"}}, refs)
+	require.True(t, tt.ctx.HasType("User"))
+
+	refs, err = tt.ctx.DropType("User", true)
+	require.NoError(t, err)
+	require.EqualValues(t, []EdgeRef{{Type: "Message", Edge: "author"}}, refs)
+	require.False(t, tt.ctx.HasType("User"))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	message := tt.getType("Message")
+	require.NotNil(t, message)
+	require.Empty(t, message.Edges)
+
+	refs, err = tt.ctx.DropType("Message", false)
+	require.NoError(t, err)
+	require.Empty(t, refs)
+	_, err = tt.ctx.DropType("Nothing", false)
+	require.EqualError(t, err, `schemast: type "Nothing" not found`)
+}
+
+func TestRenameType(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AppendEdge("User", WithType(edge.To("messages", placeholder.Type), "Message").Descriptor()))
+	require.NoError(t, tt.ctx.AppendEdge("Message", WithType(edge.From("author", placeholder.Type).Ref("messages").Unique(), "User").Descriptor()))
+	require.NoError(t, tt.ctx.AddMethod("Message", "// isMessage reports whether the Message is a message.\nfunc (m *Message) isMessage() bool { return true }"))
+	require.NoError(t, tt.ctx.AddType("Draft"))
+	require.NoError(t, tt.ctx.RenameType("Message", "Post"))
+	require.NoError(t, tt.ctx.RenameType("Draft", "PostDraft"))
+	require.EqualError(t, tt.ctx.RenameType("Message", "Other"), `schemast: type "Message" not found`)
+	require.EqualError(t, tt.ctx.RenameType("Post", "User"), `schemast: type "User" already exists`)
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("message.go")
+	require.Contains(t, contents, "// Post holds the schema definition for the Post entity.\ntype Post struct {")
+	require.Contains(t, contents, "// isMessage reports whether the Post is a message.\nfunc (m *Post) isMessage() bool")
+	require.NotContains(t, contents, "(Message)")
+	require.Contains(t, tt.contents("user.go"), `edge.To("messages", Post.Type)`)
+	require.Nil(t, tt.getType("Message"))
+	require.NotNil(t, tt.getType("PostDraft"))
+	post := tt.getType("Post")
+	require.NotNil(t, post)
+	require.EqualValues(t, "User", post.Edges[0].Type.Name)
+	require.EqualValues(t, "Post", tt.getType("User").Edges[0].Type.Name)
+}
+
+func TestCopyType(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	src, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, src.AppendEdge("WithFields", WithType(edge.To("owner", placeholder.Type).Unique(), "User").Descriptor()))
+	require.NoError(t, src.AddMethod("WithFields", "// isCopied reports whether the type was copied.\nfunc (*WithFields) isCopied() bool { return true }"))
+	require.NoError(t, src.AppendField("WithFields", field.Time("created_at").Default(sharedtest.Now).Descriptor()))
+	require.NoError(t, tt.ctx.CopyType(src, "WithFields"))
+	require.EqualError(t, tt.ctx.CopyType(src, "WithFields"), `schemast: type "WithFields" already exists`)
+	require.EqualError(t, tt.ctx.CopyType(src, "Other"), `schemast: type "Other" not found`)
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("with_fields.go")
+	require.Contains(t, contents, "// WithFields holds the schema definition for the WithFields entity.\ntype WithFields struct {")
+	require.Contains(t, contents, "// isCopied reports whether the type was copied.\nfunc (*WithFields) isCopied() bool { return true }")
+	require.Contains(t, contents, `"entgo.io/contrib/schemast/internal/sharedtest/v2"`)
+	copied := tt.getType("WithFields")
+	require.NotNil(t, copied)
+	require.Len(t, copied.Fields, 2)
+	require.EqualValues(t, "existing", copied.Fields[0].Name)
+	require.EqualValues(t, "User", copied.Edges[0].Type.Name)
+	require.True(t, src.HasType("WithFields"))
+}
+
+func TestMerge(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	src, err := Load("./internal/printtest/ent/schema")
+	require.NoError(t, err)
+	require.NoError(t, src.AddType("Pet"))
+	require.NoError(t, src.AppendField("User", field.String("name").Descriptor()))
+	require.NoError(t, src.AppendField("Message", field.String("text").Descriptor()))
+	require.EqualError(t, Merge(tt.ctx, src), `schemast: type "Message" is declared by both contexts`)
+	require.False(t, tt.ctx.HasType("Pet"))
+
+	var conflicts []string
+	require.NoError(t, Merge(tt.ctx, src, OnConflict(func(typeName string) (ConflictResolution, error) {
+		conflicts = append(conflicts, typeName)
+		if typeName == "User" {
+			return KeepSrc, nil
+		}
+		return KeepDst, nil
+	})))
+	require.EqualValues(t, []string{"Message", "User"}, conflicts)
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+	require.NotNil(t, tt.getType("Pet"))
+	require.Len(t, tt.getType("User").Fields, 1)
+	require.Len(t, tt.getType("Message").Fields, 0)
+}
+
+func TestAddMethod(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddMethod("User", `// TableName returns the name of the table of the User.
+func (User) TableName() string {
+	return "users" // Plural.
+}
+
+func (u *User) isUser() bool { return true }`))
+	err = tt.ctx.AddMethod("User", "func (User) TableName() string { return \"\" }")
+	require.EqualError(t, err, `schemast: type "User" already has a method "TableName"`)
+	err = tt.ctx.AddMethod("User", "func (Message) Other() {}")
+	require.EqualError(t, err, `schemast: expected source to only declare methods of type "User"`)
+	err = tt.ctx.AddMethod("User", "func helper() {}")
+	require.EqualError(t, err, `schemast: expected source to only declare methods of type "User"`)
+	require.Error(t, tt.ctx.AddMethod("User", "func (User) {"))
+	require.NoError(t, Mutate(tt.ctx, &UpsertSchema{Name: "User", Fields: []ent.Field{field.String("name")}}))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("user.go")
+	require.Contains(t, contents, `// TableName returns the name of the table of the User.
+func (User) TableName() string {
+	return "users" // Plural.
+}`)
+	require.Contains(t, contents, "func (u *User) isUser() bool { return true }")
+	require.Len(t, tt.getType("User").Fields, 1)
+}
+
+func TestSetTypeComment(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	require.NoError(t, tt.ctx.AddType("Team"))
+	require.NoError(t, tt.ctx.SetTypeComment("User", "User holds the schema of the users table."))
+	require.NoError(t, tt.ctx.SetTypeComment("User", "User holds the schema of the \"users\" table.\nUsers belong to teams."))
+	require.NoError(t, tt.ctx.SetTypeComment("Team", "Team is a group of users."))
+	require.NoError(t, tt.ctx.SetTypeComment("Message", "Message is removed below."))
+	require.NoError(t, tt.ctx.SetTypeComment("Message", ""))
+	require.EqualError(t, tt.ctx.SetTypeComment("Other", "Other."), `schemast: type "Other" not found`)
+	require.NoError(t, tt.ctx.AppendField("Team", field.String("name").Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("user.go"), "// User holds the schema of the \"users\" table.\n// Users belong to teams.\ntype User struct {")
+	require.Contains(t, tt.contents("team.go"), "// Team is a group of users.\ntype Team struct {")
+	require.NotContains(t, tt.contents("message.go"), "Message is removed")
+	require.Contains(t, tt.contents("message.go"), "\ntype Message struct {")
+}
+
+func TestGroupedTypeDecl(t *testing.T) {
+	ctx, err := LoadFS(fstest.MapFS{
+		"schema/types.go": {Data: []byte(`package schema
+
+import "entgo.io/ent"
+
+type (
+	// Group holds the schema definition for the Group entity.
+	Group struct {
+		ent.Schema
+	}
+	// User holds the schema definition for the User entity.
+	User struct {
+		ent.Schema
+	}
+)
+
+func (User) Fields() []ent.Field {
+	return nil
+}
+`)},
+	}, "schema/*.go")
+	require.NoError(t, err)
+	require.True(t, ctx.HasType("User"))
+	require.NoError(t, ctx.SetTypeComment("User", "User is a member of groups."))
+	require.NoError(t, ctx.RenameType("User", "Member"))
+
+	dst, err := LoadFS(fstest.MapFS{"schema/doc.go": {Data: []byte("package schema\n")}}, "schema/*.go")
+	require.NoError(t, err)
+	require.NoError(t, dst.CopyType(ctx, "Member"))
+	files, err := dst.PrintFiles()
+	require.NoError(t, err)
+	copied := string(files["member.go"])
+	require.Contains(t, copied, "// Member is a member of groups.\ntype Member struct {")
+	require.NotContains(t, copied, "Group")
+
+	require.NoError(t, ctx.RemoveType("Member"))
+	files, err = ctx.PrintFiles()
+	require.NoError(t, err)
+	require.Contains(t, string(files["types.go"]), "// Group holds the schema definition for the Group entity.\n\tGroup struct {")
+	require.NotContains(t, string(files["types.go"]), "Member")
+	require.True(t, ctx.HasType("Group"))
+}
@@ -0,0 +1,309 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"go.uber.org/multierr"
+)
+
+// UnsupportedReport loads the schema package of the Context and runs each of its fields and edges
+// through Field and Edge. It returns a map from "<type>.<field>" or "<type>.<edge>" to the list of
+// features that schemast cannot reproduce for that field or edge. Fields and edges that can be
+// converted losslessly are omitted from the map.
+//
+// Note that the report is based on the schema package as it is on disk, loaded with the options
+// given to Load (e.g. WithDir, WithBuildFlags, WithEnv or WithOverlay), and that default and
+// update-default functions are not checked, as they are not available after loading. Contexts
+// loaded with LoadFS are not supported, as their schema package cannot be loaded by the go command.
+func (c *Context) UnsupportedReport() (map[string][]string, error) {
+	if c.fsys != nil {
+		return nil, errors.New("schemast: UnsupportedReport is not supported for contexts loaded with LoadFS")
+	}
+	path, opts := c.path, c.loadOpts
+	if path == "" {
+		path = c.SchemaPackage.PkgPath
+	}
+	if opts == nil {
+		opts = &loadOpts{}
+	}
+	schemas, err := loadSchemas(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	report := make(map[string][]string)
+	for _, s := range schemas {
+		for _, f := range s.Fields {
+			if features := unsupportedFeatures(f); len(features) > 0 {
+				report[s.Name+"."+f.Name] = features
+			}
+		}
+		for _, e := range s.Edges {
+			if features := unsupportedEdgeFeatures(e); len(features) > 0 {
+				report[s.Name+"."+e.Name] = features
+			}
+		}
+	}
+	return report, nil
+}
+
+// loadSchemas loads the schemas of the package at path, like load.Config does, but with the options of
+// Load. The schemas are marshaled by a program that is run from a temporary directory in the directory
+// of the schema package, such that it belongs to the module of the package.
+func loadSchemas(path string, opts *loadOpts) ([]*load.Schema, error) {
+	pkg, err := listPackage(path, &loadOpts{
+		overlay:    opts.overlay,
+		buildFlags: opts.buildFlags,
+		env:        opts.env,
+		dir:        opts.dir,
+		syntaxOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pkg.Errors) > 0 {
+		return nil, pkg.Errors[0]
+	}
+	var names []string
+	for _, name := range (&Context{SchemaPackage: pkg}).schemaTypes() {
+		if ast.IsExported(name) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 || len(pkg.GoFiles) == 0 {
+		return nil, fmt.Errorf("schemast: no schema found in: %s", path)
+	}
+	dir, err := os.MkdirTemp(filepath.Dir(pkg.GoFiles[0]), ".schemast-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	var main bytes.Buffer
+	fmt.Fprintf(&main, schemasMainHeader, pkg.PkgPath)
+	for _, name := range names {
+		fmt.Fprintf(&main, "\tentschema.%s{},\n", name)
+	}
+	main.WriteString(schemasMainFooter)
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), main.Bytes(), 0600); err != nil {
+		return nil, err
+	}
+	args := append([]string{"run"}, opts.buildFlags...)
+	if len(opts.overlay) > 0 {
+		name, err := writeOverlay(dir, opts.overlay)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "-overlay="+name)
+	}
+	cmd := exec.Command("go", append(args, "main.go")...)
+	cmd.Dir = dir
+	if len(opts.env) > 0 {
+		cmd.Env = append(os.Environ(), opts.env...)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("schemast: load schemas of %s: %s", path, strings.TrimSpace(stderr.String()))
+	}
+	var schemas []*load.Schema
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		if line == "" {
+			continue
+		}
+		s, err := load.UnmarshalSchema([]byte(line))
+		if err != nil {
+			return nil, fmt.Errorf("schemast: unmarshal schema %s: %w", line, err)
+		}
+		schemas = append(schemas, s)
+	}
+	return schemas, nil
+}
+
+// writeOverlay writes the files of overlay to dir, along with the overlay file expected by the -overlay
+// build flag, and returns the name of the latter.
+func writeOverlay(dir string, overlay map[string][]byte) (string, error) {
+	replace := make(map[string]string, len(overlay))
+	i := 0
+	for name, src := range overlay {
+		abs, err := filepath.Abs(name)
+		if err != nil {
+			return "", err
+		}
+		i++
+		file := filepath.Join(dir, fmt.Sprintf("overlay%d", i))
+		if err := os.WriteFile(file, src, 0600); err != nil {
+			return "", err
+		}
+		replace[abs] = file
+	}
+	b, err := json.Marshal(struct{ Replace map[string]string }{Replace: replace})
+	if err != nil {
+		return "", err
+	}
+	name := filepath.Join(dir, "overlay.json")
+	return name, os.WriteFile(name, b, 0600)
+}
+
+// schemasMainHeader and schemasMainFooter hold the source of the program run by loadSchemas. As with
+// load.Config, the types that do not implement ent.Interface (e.g. schemas with pointer receivers) are
+// skipped.
+const (
+	schemasMainHeader = `package main
+
+import (
+	"bytes"
+	"os"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entc/load"
+
+	entschema %q
+)
+
+var schemas = []interface{}{
+`
+	schemasMainFooter = `}
+
+func main() {
+	var lines [][]byte
+	for _, v := range schemas {
+		schema, ok := v.(ent.Interface)
+		if !ok {
+			continue
+		}
+		b, err := load.MarshalSchema(schema)
+		if err != nil {
+			os.Stderr.WriteString(err.Error() + "\n")
+			os.Exit(1)
+		}
+		lines = append(lines, b)
+	}
+	os.Stdout.Write(bytes.Join(lines, []byte("\n")))
+}
+`
+)
+
+func unsupportedFeatures(f *load.Field) []string {
+	desc := &field.Descriptor{
+		Name:       f.Name,
+		Info:       f.Info,
+		Tag:        f.Tag,
+		Enums:      f.Enums,
+		Unique:     f.Unique,
+		Nillable:   f.Nillable,
+		Optional:   f.Optional,
+		Immutable:  f.Immutable,
+		StorageKey: f.StorageKey,
+		Sensitive:  f.Sensitive,
+		SchemaType: f.SchemaType,
+		Comment:    f.Comment,
+		Validators: make([]interface{}, f.Validators),
+	}
+	if f.Size != nil {
+		desc.Size = int(*f.Size)
+	}
+	if f.Default && f.DefaultValue != nil {
+		desc.Default = f.DefaultValue
+	}
+	var features []string
+	if _, err := Field(desc); err != nil {
+		for _, err := range multierr.Errors(err) {
+			features = append(features, err.Error())
+		}
+	}
+	return append(features, unsupportedAnnotations(f.Annotations)...)
+}
+
+func unsupportedEdgeFeatures(e *load.Edge) []string {
+	var features []string
+	if _, err := Edge(edgeDescriptor(e)); err != nil {
+		for _, err := range multierr.Errors(err) {
+			features = append(features, err.Error())
+		}
+	}
+	// Options that Edge does not generate.
+	if e.Immutable {
+		features = append(features, combineUnsupported(nil, "Descriptor.Immutable").Error())
+	}
+	if sk := e.StorageKey; sk != nil {
+		if len(sk.Symbols) > 0 {
+			features = append(features, combineUnsupported(nil, "Descriptor.StorageKey.Symbols").Error())
+		}
+		if len(sk.Columns) > 2 {
+			features = append(features, combineUnsupported(nil, "Descriptor.StorageKey.Columns").Error())
+		}
+	}
+	if e.Through != nil && e.Through.T == "" {
+		features = append(features, combineUnsupported(nil, "Descriptor.Through").Error())
+	}
+	return append(features, unsupportedAnnotations(e.Annotations)...)
+}
+
+// edgeDescriptor returns the descriptor of a loaded edge, without its annotations.
+func edgeDescriptor(e *load.Edge) *edge.Descriptor {
+	desc := &edge.Descriptor{
+		Tag:        e.Tag,
+		Type:       e.Type,
+		Name:       e.Name,
+		Field:      e.Field,
+		RefName:    e.RefName,
+		Through:    e.Through,
+		Unique:     e.Unique,
+		Inverse:    e.Inverse,
+		Required:   e.Required,
+		Immutable:  e.Immutable,
+		StorageKey: e.StorageKey,
+		Comment:    e.Comment,
+	}
+	if e.Ref != nil {
+		desc.Ref = edgeDescriptor(e.Ref)
+	}
+	return desc
+}
+
+// unsupportedAnnotations returns the errors of the loaded annotations that have no registered annotator.
+func unsupportedAnnotations(annots map[string]interface{}) []string {
+	names := make([]string, 0, len(annots))
+	for name := range annots {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var features []string
+	for _, name := range names {
+		if _, ok := lookupAnnotator(name); !ok {
+			features = append(features, (&UnsupportedAnnotationError{annot: annotationName(name)}).Error())
+		}
+	}
+	return features
+}
+
+// annotationName is a schema.Annotation that only carries the name of an annotation.
+type annotationName string
+
+// Name implements the schema.Annotation interface.
+func (a annotationName) Name() string { return string(a) }
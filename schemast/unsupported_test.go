@@ -0,0 +1,157 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContext_UnsupportedReport(t *testing.T) {
+	ctx, err := Load("./internal/mutatetest/ent/schema")
+	require.NoError(t, err)
+	report, err := ctx.UnsupportedReport()
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"schemast: unsupported feature Descriptor.Validators"}, report["WithValidator.slug"])
+	require.NotContains(t, report, "WithValidator.name")
+	require.NotContains(t, report, "WithFields.existing")
+}
+
+func TestContext_UnsupportedReportLoadFS(t *testing.T) {
+	ctx, err := LoadFS(fstest.MapFS{
+		"schema/user.go": {Data: []byte("package schema\n\nimport \"entgo.io/ent\"\n\ntype User struct {\n\tent.Schema\n}\n")},
+	}, "schema/*.go")
+	require.NoError(t, err)
+	_, err = ctx.UnsupportedReport()
+	require.EqualError(t, err, "schemast: UnsupportedReport is not supported for contexts loaded with LoadFS")
+}
+
+func TestContext_UnsupportedReportEdges(t *testing.T) {
+	dir, err := os.MkdirTemp(".", "unsupportedtest-")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "schema.go"), []byte(`package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+)
+
+type User struct {
+	ent.Schema
+}
+
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("groups", Group.Type).StorageKey(edge.Table("memberships"), edge.Symbols("user_id", "group_id")),
+		edge.To("pets", Pet.Type).Annotations(Custom{}),
+	}
+}
+
+type Group struct {
+	ent.Schema
+}
+
+func (Group) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("users", User.Type).Ref("groups"),
+	}
+}
+
+type Pet struct {
+	ent.Schema
+}
+
+func (Pet) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("pets").Unique().Immutable(),
+	}
+}
+
+type Custom struct{}
+
+func (Custom) Name() string { return "Custom" }
+`), 0600))
+
+	ctx, err := Load(dir)
+	require.NoError(t, err)
+	report, err := ctx.UnsupportedReport()
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"schemast: unsupported feature Descriptor.StorageKey.Symbols"}, report["User.groups"])
+	require.EqualValues(t, []string{`schemast: no Annotator configured for annotation "Custom"`}, report["User.pets"])
+	require.EqualValues(t, []string{"schemast: unsupported feature Descriptor.Immutable"}, report["Pet.owner"])
+	require.NotContains(t, report, "Group.users")
+}
+
+func TestContext_UnsupportedReportLoadOptions(t *testing.T) {
+	dir, err := os.MkdirTemp(".", "unsupportedtest-")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "schema"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "schema", "user.go"), []byte(`//go:build unsupportedtest
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+type User struct {
+	ent.Schema
+}
+
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Immutable(),
+	}
+}
+`), 0600))
+	pet := filepath.Join(dir, "schema", "pet.go")
+	ctx, err := Load("./schema", WithDir(dir), WithBuildFlags("-tags=unsupportedtest"), WithOverlay(map[string][]byte{
+		pet: []byte(`//go:build unsupportedtest
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+type Pet struct {
+	ent.Schema
+}
+
+func (Pet) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Validate(func(string) error { return nil }),
+	}
+}
+`),
+	}))
+	require.NoError(t, err)
+	report, err := ctx.UnsupportedReport()
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"schemast: unsupported feature Descriptor.Validators"}, report["Pet.name"])
+	require.NotContains(t, report, "User.name")
+}
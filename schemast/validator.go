@@ -0,0 +1,402 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"go/ast"
+	"go/token"
+	"math"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// validatorCall is a builder call that adds a validator to a field, e.g. MaxLen(10).
+type validatorCall struct {
+	method string
+	args   []ast.Expr
+	// pkgPath is the import path of a package referenced by args, if any.
+	pkgPath string
+}
+
+// validatorCalls returns the builder calls that add each of the validators of desc. Validators must be
+// package-level functions, functions registered with RegisterValidator, or validators added by the MinLen,
+// NotEmpty, MaxLen, Range, Min, Max, Positive, Negative and NonNegative builders of the ent field package.
+// It reports false if a validator cannot be converted, e.g. a closure or a Match validator, whose regular
+// expression cannot be recovered (see UpsertSchema, which keeps the Match calls of existing fields).
+func validatorCalls(desc *field.Descriptor) ([]validatorCall, bool) {
+	calls := make([]validatorCall, 0, len(desc.Validators))
+	for _, v := range desc.Validators {
+		if v == nil || reflect.TypeOf(v).Kind() != reflect.Func {
+			return nil, false
+		}
+		if sel, pkgPath, err := funcSelector(v); err == nil {
+			calls = append(calls, validatorCall{method: "Validate", args: []ast.Expr{sel}, pkgPath: pkgPath})
+			continue
+		}
+		call, ok := builtinValidator(desc, v)
+		if !ok {
+			return nil, false
+		}
+		calls = append(calls, call)
+	}
+	return calls, true
+}
+
+// Errors returned by the validators of the ent field package.
+const (
+	errMinLen = "value is less than the required length"
+	errMaxLen = "value is greater than the required length"
+	errRange  = "value out of range"
+)
+
+// maxProbeLen is the length of the longest value that length validators are invoked with.
+const maxProbeLen = 1 << 20
+
+// funcSource is the position of the declaration of a function.
+type funcSource struct {
+	file string
+	line int
+}
+
+// sourceOf returns the position of the declaration of the function fn.
+func sourceOf(fn interface{}) funcSource {
+	f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
+	if f == nil {
+		return funcSource{}
+	}
+	file, line := f.FileLine(f.Entry())
+	return funcSource{file: file, line: line}
+}
+
+// builtinValidators maps the positions of the closures created by the validator builders of the ent field
+// package to the builders that create them. The builders are usually inlined, which gives their closures
+// the name of the caller, but the closures keep the position of their declaration in the ent field package.
+var builtinValidators = func() map[funcSource]string {
+	builders := map[string][]ent.Field{
+		"MinLen": {field.String("").MinLen(1), field.Bytes("").MinLen(1)},
+		"MaxLen": {field.String("").MaxLen(1), field.Bytes("").MaxLen(1)},
+		"Min": {
+			field.Int("").Min(0), field.Int8("").Min(0), field.Int16("").Min(0), field.Int32("").Min(0),
+			field.Int64("").Min(0), field.Uint("").Min(0), field.Uint8("").Min(0), field.Uint16("").Min(0),
+			field.Uint32("").Min(0), field.Uint64("").Min(0), field.Float("").Min(0), field.Float32("").Min(0),
+		},
+		"Max": {
+			field.Int("").Max(0), field.Int8("").Max(0), field.Int16("").Max(0), field.Int32("").Max(0),
+			field.Int64("").Max(0), field.Uint("").Max(0), field.Uint8("").Max(0), field.Uint16("").Max(0),
+			field.Uint32("").Max(0), field.Uint64("").Max(0), field.Float("").Max(0), field.Float32("").Max(0),
+		},
+		"Range": {
+			field.Int("").Range(0, 0), field.Int8("").Range(0, 0), field.Int16("").Range(0, 0),
+			field.Int32("").Range(0, 0), field.Int64("").Range(0, 0), field.Uint("").Range(0, 0),
+			field.Uint8("").Range(0, 0), field.Uint16("").Range(0, 0), field.Uint32("").Range(0, 0),
+			field.Uint64("").Range(0, 0), field.Float("").Range(0, 0), field.Float32("").Range(0, 0),
+		},
+	}
+	sources := make(map[funcSource]string)
+	for method, fields := range builders {
+		for _, f := range fields {
+			for _, v := range f.Descriptor().Validators {
+				sources[sourceOf(v)] = method
+			}
+		}
+	}
+	return sources
+}()
+
+// builtinValidator converts a validator that was created by a builder of the ent field package back to
+// the builder call that created it. The builder is recognized by the position of the closure, and its
+// arguments, which are captured by the closure, are recovered by invoking the validator with probe values.
+func builtinValidator(desc *field.Descriptor, fn interface{}) (validatorCall, bool) {
+	method, ok := builtinValidators[sourceOf(fn)]
+	if !ok {
+		return validatorCall{}, false
+	}
+	switch v := fn.(type) {
+	case func(string) error:
+		return lenValidator(desc, method, func(n int) error {
+			return v(strings.Repeat("a", n))
+		})
+	case func([]byte) error:
+		return lenValidator(desc, method, func(n int) error {
+			return v(make([]byte, n))
+		})
+	}
+	return numericValidator(desc, method, reflect.ValueOf(fn))
+}
+
+// lenValidator converts a MinLen, NotEmpty or MaxLen validator of the ent field package. validate invokes
+// the validator with a value of length n.
+func lenValidator(desc *field.Descriptor, method string, validate func(n int) error) (validatorCall, bool) {
+	switch method {
+	case "MinLen":
+		// MinLen(i) accepts the values of length i or more.
+		hi := 1
+		for validate(hi) != nil {
+			if hi *= 2; hi > maxProbeLen {
+				return validatorCall{}, false
+			}
+		}
+		i := hi/2 + int(firstKey(0, uint64(hi-hi/2), func(k uint64) bool { return validate(hi/2+int(k)) == nil }))
+		if i == 0 || !isErr(validate(i-1), errMinLen) {
+			return validatorCall{}, false
+		}
+		if i == 1 {
+			return validatorCall{method: "NotEmpty"}, true
+		}
+		return validatorCall{method: "MinLen", args: []ast.Expr{intLit(i)}}, true
+	case "MaxLen":
+		// MaxLen sets the size of the field, which is the length of the last MaxLen validator.
+		n := 0
+		for _, v := range desc.Validators {
+			if builtinValidators[sourceOf(v)] == "MaxLen" {
+				n++
+			}
+		}
+		i := desc.Size
+		if i <= 0 || n != 1 || i <= maxProbeLen && (validate(i) != nil || !isErr(validate(i+1), errMaxLen)) {
+			return validatorCall{}, false
+		}
+		return validatorCall{method: "MaxLen", args: []ast.Expr{intLit(i)}}, true
+	}
+	return validatorCall{}, false
+}
+
+// numericValidator converts a Range, Min, Max, Positive, Negative or NonNegative validator of the ent
+// field package. The bounds are found by binary search on the values of the type of the field, ordered by
+// numericKey.
+func numericValidator(desc *field.Descriptor, method string, fn reflect.Value) (validatorCall, bool) {
+	if fn.Type().NumIn() != 1 {
+		return validatorCall{}, false
+	}
+	t := fn.Type().In(0)
+	lower, upper, ok := numericBounds(t)
+	if !ok {
+		return validatorCall{}, false
+	}
+	validate := func(x reflect.Value) error {
+		err, _ := fn.Call([]reflect.Value{x})[0].Interface().(error)
+		return err
+	}
+	accepts := func(k uint64) bool {
+		return validate(fromNumericKey(t, k)) == nil
+	}
+	lo, hi := numericKey(lower), numericKey(upper)
+	// p is the key of a value accepted by the validator.
+	var p uint64
+	switch {
+	case method == "Min" && validate(upper) == nil:
+		p = hi
+	case method == "Max" && validate(lower) == nil:
+		p = lo
+	case method == "Range":
+		if p, ok = rangeProbe(desc, t, accepts); !ok {
+			return validatorCall{}, false
+		}
+	default:
+		return validatorCall{}, false
+	}
+	i, j := fromNumericKey(t, firstKey(lo, p, accepts)), fromNumericKey(t, lastKey(p, hi, accepts))
+	// The bounds are verified by invoking the validator with their adjacent values.
+	if method != "Max" && !isErr(validate(adjacent(i, -1)), errRange) ||
+		method != "Min" && !isErr(validate(adjacent(j, 1)), errRange) {
+		return validatorCall{}, false
+	}
+	positive, negative := "1", "-1"
+	if k := t.Kind(); k == reflect.Float32 || k == reflect.Float64 {
+		positive, negative = "1e-06", "-1e-06"
+	}
+	switch method {
+	case "Range":
+		return validatorCall{method: "Range", args: []ast.Expr{numericLit(i), numericLit(j)}}, true
+	case "Min":
+		switch lit := numericLit(i).(*ast.BasicLit).Value; {
+		case lit == positive:
+			return validatorCall{method: "Positive"}, true
+		case lit == "0" && t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64:
+			return validatorCall{method: "NonNegative"}, true
+		}
+		return validatorCall{method: "Min", args: []ast.Expr{numericLit(i)}}, true
+	default:
+		if numericLit(j).(*ast.BasicLit).Value == negative {
+			return validatorCall{method: "Negative"}, true
+		}
+		return validatorCall{method: "Max", args: []ast.Expr{numericLit(j)}}, true
+	}
+}
+
+// rangeProbe returns the key of a value accepted by a Range validator, looking for one among the default
+// value of the field, zero, small integers and powers of 2 and 10.
+func rangeProbe(desc *field.Descriptor, t reflect.Type, accepts func(uint64) bool) (uint64, bool) {
+	var probes []float64
+	if d := reflect.ValueOf(desc.Default); d.IsValid() && d.CanConvert(reflect.TypeOf(float64(0))) {
+		probes = append(probes, d.Convert(reflect.TypeOf(float64(0))).Float())
+	}
+	probes = append(probes, 0)
+	for i := 1; i <= 1024; i++ {
+		probes = append(probes, float64(i), -float64(i))
+	}
+	for e := -30; e <= 64; e++ {
+		probes = append(probes, math.Ldexp(1, e), -math.Ldexp(1, e))
+	}
+	for e := -9; e <= 19; e++ {
+		probes = append(probes, math.Pow10(e), -math.Pow10(e))
+	}
+	lower, upper, _ := numericBounds(t)
+	for _, f := range probes {
+		x := reflect.New(t).Elem()
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if f != math.Trunc(f) || f < float64(lower.Int()) || f >= -float64(lower.Int()) {
+				continue
+			}
+			x.SetInt(int64(f))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if f != math.Trunc(f) || f < 0 || f > float64(upper.Uint()) {
+				continue
+			}
+			x.SetUint(uint64(f))
+		default:
+			x.SetFloat(f)
+		}
+		if k := numericKey(x); accepts(k) {
+			return k, true
+		}
+	}
+	return 0, false
+}
+
+// numericKey maps the numeric value v to a key, such that the keys of values of the same type have the
+// order of the values.
+func numericKey(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(v.Int()) ^ 1<<63
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	default:
+		// The bits of negative floats are inverted, such that lower values have lower keys.
+		bits := math.Float64bits(v.Float())
+		if bits>>63 == 1 {
+			return ^bits
+		}
+		return bits | 1<<63
+	}
+}
+
+// fromNumericKey returns the value of type t with the key k (see numericKey). Float32 values are rounded
+// to the nearest value, and negative zeros are returned as zeros.
+func fromNumericKey(t reflect.Type, k uint64) reflect.Value {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(k ^ 1<<63))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(k)
+	default:
+		bits := ^k
+		if k>>63 == 1 {
+			bits = k &^ (1 << 63)
+		}
+		v.SetFloat(math.Float64frombits(bits) + 0)
+	}
+	return v
+}
+
+// firstKey returns the lowest key in [lo, hi] for which ok reports true, given that ok(hi) is true and that
+// ok reports true for all the keys that follow one for which it does.
+func firstKey(lo, hi uint64, ok func(uint64) bool) uint64 {
+	for lo < hi {
+		if mid := lo + (hi-lo)/2; ok(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
+
+// lastKey returns the highest key in [lo, hi] for which ok reports true, given that ok(lo) is true and that
+// ok reports true for all the keys that precede one for which it does.
+func lastKey(lo, hi uint64, ok func(uint64) bool) uint64 {
+	for lo < hi {
+		if mid := hi - (hi-lo)/2; ok(mid) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo
+}
+
+// adjacent returns the numeric value that follows v if dir is positive, or precedes it otherwise.
+// Values that overflow the type wrap around.
+func adjacent(v reflect.Value, dir int) reflect.Value {
+	adj := reflect.New(v.Type()).Elem()
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		adj.SetInt(v.Int() + int64(dir))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		adj.SetUint(v.Uint() + uint64(dir))
+	case reflect.Float32:
+		adj.SetFloat(float64(math.Nextafter32(float32(v.Float()), float32(math.Inf(dir)))))
+	default:
+		adj.SetFloat(math.Nextafter(v.Float(), math.Inf(dir)))
+	}
+	return adj
+}
+
+// numericBounds returns the lowest and highest values of the numeric type t.
+func numericBounds(t reflect.Type) (reflect.Value, reflect.Value, bool) {
+	lower, upper := reflect.New(t).Elem(), reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bits := t.Bits()
+		lower.SetInt(-1 << (bits - 1))
+		upper.SetInt(1<<(bits-1) - 1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		upper.SetUint(1<<t.Bits() - 1)
+	case reflect.Float32:
+		lower.SetFloat(-math.MaxFloat32)
+		upper.SetFloat(math.MaxFloat32)
+	case reflect.Float64:
+		lower.SetFloat(-math.MaxFloat64)
+		upper.SetFloat(math.MaxFloat64)
+	default:
+		return reflect.Value{}, reflect.Value{}, false
+	}
+	return lower, upper, true
+}
+
+// numericLit returns the literal of the numeric value v.
+func numericLit(v reflect.Value) ast.Expr {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &ast.BasicLit{Kind: token.INT, Value: strconv.FormatInt(v.Int(), 10)}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &ast.BasicLit{Kind: token.INT, Value: strconv.FormatUint(v.Uint(), 10)}
+	default:
+		return &ast.BasicLit{Kind: token.FLOAT, Value: strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())}
+	}
+}
+
+// isErr reports whether err is not nil and has the message msg.
+func isErr(err error, msg string) bool {
+	return err != nil && err.Error() == msg
+}
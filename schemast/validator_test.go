@@ -0,0 +1,191 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"bytes"
+	"errors"
+	"go/printer"
+	"go/token"
+	"regexp"
+	"testing"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltinValidators(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    ent.Field
+		expected string
+	}{
+		{
+			name:     "string max len",
+			field:    field.String("x").MaxLen(10),
+			expected: `field.String("x").MaxLen(10)`,
+		},
+		{
+			name:     "string min len",
+			field:    field.String("x").MinLen(3),
+			expected: `field.String("x").MinLen(3)`,
+		},
+		{
+			name:     "string not empty",
+			field:    field.String("x").NotEmpty().MaxLen(255).Optional(),
+			expected: `field.String("x").Optional().NotEmpty().MaxLen(255)`,
+		},
+		{
+			name:     "text not empty",
+			field:    field.Text("x").NotEmpty(),
+			expected: `field.Text("x").NotEmpty()`,
+		},
+		{
+			name:     "bytes",
+			field:    field.Bytes("x").MinLen(2).MaxLen(64),
+			expected: `field.Bytes("x").MinLen(2).MaxLen(64)`,
+		},
+		{
+			name:     "bytes not empty",
+			field:    field.Bytes("x").NotEmpty(),
+			expected: `field.Bytes("x").NotEmpty()`,
+		},
+		{
+			name:     "int range",
+			field:    field.Int("x").Range(1, 10),
+			expected: `field.Int("x").Range(1, 10)`,
+		},
+		{
+			name:     "int range holding the default value",
+			field:    field.Int("x").Range(1500, 1600).Default(1550),
+			expected: `field.Int("x").Range(1500, 1600).Default(1550)`,
+		},
+		{
+			name:     "int8 min max",
+			field:    field.Int8("x").Min(-5).Max(5),
+			expected: `field.Int8("x").Min(-5).Max(5)`,
+		},
+		{
+			name:     "int64 positive",
+			field:    field.Int64("x").Positive(),
+			expected: `field.Int64("x").Positive()`,
+		},
+		{
+			name:     "int negative",
+			field:    field.Int("x").Negative(),
+			expected: `field.Int("x").Negative()`,
+		},
+		{
+			name:     "int32 non negative",
+			field:    field.Int32("x").NonNegative(),
+			expected: `field.Int32("x").NonNegative()`,
+		},
+		{
+			name:     "uint16 range",
+			field:    field.Uint16("x").Range(10, 65000),
+			expected: `field.Uint16("x").Range(10, 65000)`,
+		},
+		{
+			name:     "uint64 max",
+			field:    field.Uint64("x").Positive().Max(1 << 40),
+			expected: `field.Uint64("x").Positive().Max(1099511627776)`,
+		},
+		{
+			name:     "float range",
+			field:    field.Float("x").Range(-1.5, 99.25),
+			expected: `field.Float("x").Range(-1.5, 99.25)`,
+		},
+		{
+			name:     "float min zero",
+			field:    field.Float("x").Min(0),
+			expected: `field.Float("x").Min(0)`,
+		},
+		{
+			name:     "float32 min",
+			field:    field.Float32("x").Min(0.1),
+			expected: `field.Float32("x").Min(0.1)`,
+		},
+		{
+			name:     "float32 positive",
+			field:    field.Float32("x").Positive(),
+			expected: `field.Float32("x").Positive()`,
+		},
+		{
+			name:     "float negative",
+			field:    field.Float("x").Negative().Optional(),
+			expected: `field.Float("x").Optional().Negative()`,
+		},
+		{
+			name:     "with package-level validator",
+			field:    field.String("x").MaxLen(10).Validate(validateName),
+			expected: `field.String("x").MaxLen(10).Validate(schemast.validateName)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Field(tt.field.Descriptor())
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, printer.Fprint(&buf, token.NewFileSet(), r))
+			require.EqualValues(t, tt.expected, buf.String())
+		})
+	}
+}
+
+func TestPrintBuiltinValidators(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+
+	require.NoError(t, tt.ctx.AppendField("Message", field.String("title").NotEmpty().MaxLen(20).Descriptor()))
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	require.Contains(t, tt.contents("message.go"), `field.String("title").NotEmpty().MaxLen(20)`)
+	title := tt.getType("Message").Fields[0]
+	require.EqualValues(t, 2, title.Validators)
+	require.EqualValues(t, 20, title.Column().Size)
+}
+
+func TestCustomValidatorNotConverted(t *testing.T) {
+	_, err := Field(field.Int("x").Validate(func(i int) error {
+		if i < 0 {
+			return errors.New("value out of range")
+		}
+		return nil
+	}).Descriptor())
+	require.EqualError(t, err, "schemast: unsupported feature Descriptor.Validators")
+
+	_, err = Field(field.Uint8("x").Validate(func(i uint8) error {
+		panic("unexpected value")
+	}).Descriptor())
+	require.EqualError(t, err, "schemast: unsupported feature Descriptor.Validators")
+
+	// Closures are not converted, even if they behave like the validators of the ent field package.
+	_, err = Field(field.String("x").MaxLen(10).Validate(func(string) error { return nil }).Descriptor())
+	require.EqualError(t, err, "schemast: unsupported feature Descriptor.Validators")
+
+	// The bounds of Range validators are only found if the range holds one of the probe values.
+	_, err = Field(field.Int("x").Range(1025, 1030).Descriptor())
+	require.EqualError(t, err, "schemast: unsupported feature Descriptor.Validators")
+
+	// The regular expressions of Match validators are not exposed by the descriptor.
+	_, err = Field(field.String("slug").Match(regexp.MustCompile(`^[a-z-]+$`)).Descriptor())
+	require.EqualError(t, err, "schemast: unsupported feature Descriptor.Validators")
+}
+
+func validateName(string) error {
+	return nil
+}